@@ -5,8 +5,6 @@ import (
     "bytes"
     "context"
     "encoding/json"
-    "encoding/base64"
-    "strings"
     "fmt"
     "net/http"
 )
@@ -88,31 +86,6 @@ func (c *Client) GetCLIKeys(ctx context.Context, organizationID string) ([]CLIKe
     return cliKeys, nil
 }
 
-func (c *Client) GetUserIDFromToken() (string, error) {
-    parts := strings.Split(c.accessToken, ".")
-    if len(parts) != 3 {
-        return "", fmt.Errorf("invalid access token format")
-    }
-
-    payload, err := base64.RawURLEncoding.DecodeString(parts[1])
-    if err != nil {
-        return "", err
-    }
-
-    var claims map[string]interface{}
-    err = json.Unmarshal(payload, &claims)
-    if err != nil {
-        return "", err
-    }
-
-    userID, ok := claims["id"].(string)
-    if !ok {
-        return "", fmt.Errorf("user ID not found in access token")
-    }
-
-    return userID, nil
-}
-
 func (c *Client) UpdateCLIKey(ctx context.Context, organizationID, keyID, newName string) (*CLIKey, error) {
     url := fmt.Sprintf("%s/organizations/%s/cli-keys/%s", c.baseURL, organizationID, keyID)
 
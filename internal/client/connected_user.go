@@ -0,0 +1,175 @@
+package client
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/url"
+)
+
+type ConnectedUserIntegration struct {
+    IntegrationID    string `json:"integrationId"`
+    Type             string `json:"type"`
+    CredentialID     string `json:"credentialId"`
+    CredentialStatus string `json:"credentialStatus"`
+}
+
+type ConnectedUser struct {
+    ID             string                     `json:"id"`
+    DateCreated    string                     `json:"dateCreated"`
+    DateUpdated    string                     `json:"dateUpdated"`
+    DateLastActive string                     `json:"dateLastActive"`
+    ProjectID      string                     `json:"projectId"`
+    UserID         string                     `json:"externalId"`
+    Meta           map[string]any             `json:"meta"`
+    Integrations   []ConnectedUserIntegration `json:"integrations"`
+}
+
+// ConnectedUsersResponse represents the paginated response from the connected users API
+type ConnectedUsersResponse struct {
+    Items          []ConnectedUser `json:"items"`
+    NextPageCursor *string         `json:"nextPageCursor"`
+}
+
+// GetConnectedUsers returns every connected user of the project, following the pages of the response.
+func (c *Client) GetConnectedUsers(ctx context.Context, projectID string) ([]ConnectedUser, error) {
+    var connectedUsers []ConnectedUser
+    cursor := ""
+
+    for {
+        requestURL := fmt.Sprintf("%s/projects/%s/connected-users", c.baseURL, projectID)
+        if cursor != "" {
+            requestURL += "?cursor=" + url.QueryEscape(cursor)
+        }
+
+        req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+        if err != nil {
+            return nil, err
+        }
+        req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+        resp, err := c.httpClient.Do(req)
+        if err != nil {
+            return nil, err
+        }
+
+        if resp.StatusCode != http.StatusOK {
+            resp.Body.Close()
+            return nil, fmt.Errorf("failed to get connected users with status code: %d", resp.StatusCode)
+        }
+
+        var connectedUsersResponse ConnectedUsersResponse
+        err = json.NewDecoder(resp.Body).Decode(&connectedUsersResponse)
+        resp.Body.Close()
+        if err != nil {
+            return nil, err
+        }
+
+        connectedUsers = append(connectedUsers, connectedUsersResponse.Items...)
+        if connectedUsersResponse.NextPageCursor == nil || *connectedUsersResponse.NextPageCursor == "" {
+            return connectedUsers, nil
+        }
+        cursor = *connectedUsersResponse.NextPageCursor
+    }
+}
+
+func (c *Client) GetConnectedUser(ctx context.Context, projectID, connectedUserID string) (*ConnectedUser, error) {
+    url := fmt.Sprintf("%s/projects/%s/connected-users/%s", c.baseURL, projectID, connectedUserID)
+
+    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode == http.StatusNotFound {
+        return nil, fmt.Errorf("status code: 404")
+    }
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to get connected user with status code: %d", resp.StatusCode)
+    }
+
+    var connectedUser ConnectedUser
+    err = json.NewDecoder(resp.Body).Decode(&connectedUser)
+    if err != nil {
+        return nil, err
+    }
+
+    return &connectedUser, nil
+}
+
+// UpdateConnectedUserMetadata replaces the metadata of a connected user.
+func (c *Client) UpdateConnectedUserMetadata(ctx context.Context, projectID, connectedUserID string, meta map[string]any) (*ConnectedUser, error) {
+    url := fmt.Sprintf("%s/projects/%s/connected-users/%s", c.baseURL, projectID, connectedUserID)
+
+    jsonBody, err := json.Marshal(map[string]any{"meta": meta})
+    if err != nil {
+        return nil, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode == http.StatusNotFound {
+        return nil, fmt.Errorf("status code: 404")
+    }
+
+    if resp.StatusCode != http.StatusOK {
+        errorMessage := formatErrorMessage(resp)
+        return nil, fmt.Errorf("%s, status code: %d", errorMessage, resp.StatusCode)
+    }
+
+    var connectedUser ConnectedUser
+    err = json.NewDecoder(resp.Body).Decode(&connectedUser)
+    if err != nil {
+        return nil, err
+    }
+
+    return &connectedUser, nil
+}
+
+// DisconnectConnectedUserCredential disconnects an integration of a connected user by deleting its credential.
+func (c *Client) DisconnectConnectedUserCredential(ctx context.Context, projectID, connectedUserID, credentialID string) error {
+    url := fmt.Sprintf("%s/projects/%s/connected-users/%s/credentials/%s", c.baseURL, projectID, connectedUserID, credentialID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode == http.StatusNotFound {
+        return nil
+    }
+
+    if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+        return fmt.Errorf("failed to disconnect connected user credential with status code: %d", resp.StatusCode)
+    }
+
+    return nil
+}
@@ -7,6 +7,8 @@ import (
     "encoding/json"
     "fmt"
     "net/http"
+    "regexp"
+    "strings"
 )
 
 type EnvironmentSecret struct {
@@ -148,3 +150,103 @@ func (c *Client) DeleteEnvironmentSecret(ctx context.Context, projectID, secretI
 
     return nil
 }
+
+// EnvironmentSecretReference is a workflow step whose parameters reference an environment secret.
+type EnvironmentSecretReference struct {
+    WorkflowID          string
+    WorkflowDescription string
+    IntegrationID       string
+    StepID              string
+    StepType            string
+}
+
+// GetEnvironmentSecretReferences returns the workflow steps referencing each environment secret of the project, keyed by secret key.
+// Every secret of the project has an entry, empty when it is not referenced.
+func (c *Client) GetEnvironmentSecretReferences(ctx context.Context, projectID string) (map[string][]EnvironmentSecretReference, error) {
+    secrets, err := c.GetEnvironmentSecrets(ctx, projectID)
+    if err != nil {
+        return nil, err
+    }
+
+    workflows, err := c.GetWorkflows(ctx, projectID, "")
+    if err != nil {
+        return nil, err
+    }
+
+    for i, workflow := range workflows {
+        workflows[i], err = c.LoadWorkflowSteps(ctx, projectID, workflow)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    return FindEnvironmentSecretReferences(secrets, workflows), nil
+}
+
+// FindEnvironmentSecretReferences finds the workflow steps referencing each secret, keyed by secret key.
+// A step references a secret when a string in its parameters holds the secret ID, or a {{...}} token
+// naming the secret key (e.g. {{$.secrets.API_KEY}}).
+func FindEnvironmentSecretReferences(secrets []EnvironmentSecret, workflows []Workflow) map[string][]EnvironmentSecretReference {
+    references := make(map[string][]EnvironmentSecretReference)
+    keyTokens := make([]*regexp.Regexp, len(secrets))
+    for i, secret := range secrets {
+        references[secret.Key] = []EnvironmentSecretReference{}
+        keyTokens[i] = secretKeyToken(secret.Key)
+    }
+
+    for _, workflow := range workflows {
+        for _, step := range workflow.Steps {
+            var values []string
+            collectStrings(step.Parameters, &values)
+
+            for i, secret := range secrets {
+                if !referencesSecret(values, secret, keyTokens[i]) {
+                    continue
+                }
+                references[secret.Key] = append(references[secret.Key], EnvironmentSecretReference{
+                    WorkflowID:          workflow.ID,
+                    WorkflowDescription: workflow.Description,
+                    IntegrationID:       workflow.IntegrationID,
+                    StepID:              step.ID,
+                    StepType:            step.Type,
+                })
+            }
+        }
+    }
+
+    return references
+}
+
+// secretKeyToken matches a {{...}} token naming the secret key.
+func secretKeyToken(key string) *regexp.Regexp {
+    return regexp.MustCompile(`\{\{[^}]*secrets?\.` + regexp.QuoteMeta(key) + `(?:[^A-Za-z0-9_}][^}]*)?\}\}`)
+}
+
+// referencesSecret reports whether one of the values holds the secret ID or matches its key token.
+func referencesSecret(values []string, secret EnvironmentSecret, keyToken *regexp.Regexp) bool {
+    for _, value := range values {
+        if secret.ID != "" && strings.Contains(value, secret.ID) {
+            return true
+        }
+        if keyToken.MatchString(value) {
+            return true
+        }
+    }
+    return false
+}
+
+// collectStrings collects every string found in a decoded JSON value.
+func collectStrings(value interface{}, values *[]string) {
+    switch v := value.(type) {
+    case string:
+        *values = append(*values, v)
+    case map[string]interface{}:
+        for _, item := range v {
+            collectStrings(item, values)
+        }
+    case []interface{}:
+        for _, item := range v {
+            collectStrings(item, values)
+        }
+    }
+}
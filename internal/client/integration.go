@@ -31,6 +31,24 @@ type IntegrationConfig struct {
     Values       map[string]any         `json:"values"`
 }
 
+// Keys of the Connect Portal configuration in IntegrationConfig.Values
+const (
+    IntegrationConfigDescription  = "description"
+    IntegrationConfigOverview     = "overview"
+    IntegrationConfigAccentColor  = "accentColor"
+    IntegrationConfigSharedMeta   = "sharedMeta"
+    IntegrationConfigWorkflowMeta = "workflowMeta"
+)
+
+// IntegrationConfigInput is a setting input shown to the connected users in the Connect Portal.
+type IntegrationConfigInput struct {
+    ID       string `json:"id"`
+    Title    string `json:"title"`
+    Type     string `json:"type"`
+    Required bool   `json:"required"`
+    Tooltip  string `json:"tooltip,omitempty"`
+}
+
 type CustomIntegration struct {
     ID                 string      `json:"id"`
     DateCreated        string      `json:"dateCreated"`
@@ -118,20 +136,6 @@ func (c *Client) GetCredentials(ctx context.Context, projectID string) ([]Creden
     return credentials, nil
 }
 
-func (c *Client) GetUserEmailFromToken() (string, error) {
-    // Extract the user email from the access token (c.accessToken)
-    // Implement the logic to parse the JWT and retrieve the "email" field
-    // Return the email and any error encountered
-    // Example implementation:
-    // token, _ := jwt.Parse(c.accessToken, nil)
-    // claims, _ := token.Claims.(jwt.MapClaims)
-    // email, _ := claims["email"].(string)
-    // return email, nil
-
-    // Placeholder implementation
-    return "user@example.com", nil
-}
-
 type CreateIntegrationCredentialsRequest struct {
     Name          string         `json:"name"`
     Values        map[string]any `json:"values"`
@@ -319,3 +323,148 @@ func (c *Client) GetIntegration(ctx context.Context, projectID, integrationID st
 
     return &integration, nil
 }
+
+// CreateIntegrationRequest adds a catalog integration (by type) or a custom integration (by its ID) to a project.
+type CreateIntegrationRequest struct {
+    Type                string `json:"type"`
+    CustomIntegrationID string `json:"customIntegrationId,omitempty"`
+}
+
+func (c *Client) CreateIntegration(ctx context.Context, projectID string, createReq CreateIntegrationRequest) (*Integration, error) {
+    url := fmt.Sprintf("%s/projects/%s/integrations", c.baseURL, projectID)
+
+    jsonBody, err := json.Marshal(createReq)
+    if err != nil {
+        return nil, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+        errorMessage := formatErrorMessage(resp)
+        return nil, fmt.Errorf("%s, status code: %d", errorMessage, resp.StatusCode)
+    }
+
+    var integration Integration
+    err = json.NewDecoder(resp.Body).Decode(&integration)
+    if err != nil {
+        return nil, err
+    }
+
+    return &integration, nil
+}
+
+func (c *Client) DeleteIntegration(ctx context.Context, projectID, integrationID string) error {
+    url := fmt.Sprintf("%s/projects/%s/integrations/%s", c.baseURL, projectID, integrationID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode == http.StatusNotFound {
+        return nil
+    }
+
+    if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+        return fmt.Errorf("failed to delete integration with status code: %d", resp.StatusCode)
+    }
+
+    return nil
+}
+
+// UpdateIntegrationConfig replaces the values of an integration configuration.
+func (c *Client) UpdateIntegrationConfig(ctx context.Context, projectID, integrationID, configID string, values map[string]any) (*IntegrationConfig, error) {
+    url := fmt.Sprintf("%s/projects/%s/integrations/%s/configs/%s", c.baseURL, projectID, integrationID, configID)
+
+    jsonBody, err := json.Marshal(map[string]any{"values": values})
+    if err != nil {
+        return nil, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode == http.StatusNotFound {
+        return nil, fmt.Errorf("status code: 404")
+    }
+
+    if resp.StatusCode != http.StatusOK {
+        errorMessage := formatErrorMessage(resp)
+        return nil, fmt.Errorf("%s, status code: %d", errorMessage, resp.StatusCode)
+    }
+
+    var config IntegrationConfig
+    err = json.NewDecoder(resp.Body).Decode(&config)
+    if err != nil {
+        return nil, err
+    }
+
+    return &config, nil
+}
+
+// IntegrationCatalogEntry describes an integration type Paragon supports.
+type IntegrationCatalogEntry struct {
+    Type                      string   `json:"type"`
+    Name                      string   `json:"name"`
+    AuthenticationType        string   `json:"authenticationType"`
+    DefaultScopes             []string `json:"defaultScopes"`
+    AvailableScopes           []string `json:"availableScopes"`
+    RequiredConfigurationKeys []string `json:"requiredConfigurationKeys"`
+}
+
+func (c *Client) GetIntegrationCatalog(ctx context.Context, projectID string) ([]IntegrationCatalogEntry, error) {
+    url := fmt.Sprintf("%s/projects/%s/integrations/catalog", c.baseURL, projectID)
+
+    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to get integration catalog with status code: %d", resp.StatusCode)
+    }
+
+    var catalog []IntegrationCatalogEntry
+    err = json.NewDecoder(resp.Body).Decode(&catalog)
+    if err != nil {
+        return nil, err
+    }
+
+    return catalog, nil
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+    "crypto"
+    "crypto/rand"
+    "crypto/rsa"
+    "crypto/sha256"
+    "crypto/x509"
+    "encoding/base64"
+    "encoding/json"
+    "encoding/pem"
+    "fmt"
+    "strings"
+    "time"
+)
+
+// ParseRSAPrivateKey parses a PEM encoded RSA private key (PKCS#8 or PKCS#1), as returned when creating an SDK key.
+func ParseRSAPrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+    // Keys copied from JSON often keep their newlines escaped
+    privateKeyPEM = strings.ReplaceAll(privateKeyPEM, `\n`, "\n")
+
+    block, _ := pem.Decode([]byte(privateKeyPEM))
+    if block == nil {
+        return nil, fmt.Errorf("invalid private key: no PEM block found")
+    }
+
+    if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+        return key, nil
+    }
+
+    key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+    if err != nil {
+        return nil, fmt.Errorf("invalid private key: %v", err)
+    }
+
+    rsaKey, ok := key.(*rsa.PrivateKey)
+    if !ok {
+        return nil, fmt.Errorf("invalid private key: not an RSA key")
+    }
+
+    return rsaKey, nil
+}
+
+// SignUserToken creates an RS256 paragon user token for userID, as expected by the Connect SDK authenticate call.
+// Metadata, if any, is passed in the "meta" claim.
+func SignUserToken(privateKeyPEM, userID string, ttl time.Duration, metadata map[string]string, now time.Time) (string, error) {
+    key, err := ParseRSAPrivateKey(privateKeyPEM)
+    if err != nil {
+        return "", err
+    }
+
+    if userID == "" {
+        return "", fmt.Errorf("user ID must not be empty")
+    }
+
+    if ttl <= 0 {
+        return "", fmt.Errorf("ttl must be positive")
+    }
+
+    header := map[string]string{
+        "alg": SDKKeySigningAlgorithm,
+        "typ": "JWT",
+    }
+
+    claims := map[string]any{
+        "sub": userID,
+        "iat": now.Unix(),
+        "exp": now.Add(ttl).Unix(),
+    }
+    if len(metadata) > 0 {
+        claims["meta"] = metadata
+    }
+
+    encodedHeader, err := encodeJWTSegment(header)
+    if err != nil {
+        return "", err
+    }
+
+    encodedClaims, err := encodeJWTSegment(claims)
+    if err != nil {
+        return "", err
+    }
+
+    signingInput := encodedHeader + "." + encodedClaims
+    digest := sha256.Sum256([]byte(signingInput))
+
+    signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+    if err != nil {
+        return "", err
+    }
+
+    return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func encodeJWTSegment(value any) (string, error) {
+    encoded, err := json.Marshal(value)
+    if err != nil {
+        return "", err
+    }
+
+    return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// AccessTokenClaims holds the claims of the access token the client is authenticated with.
+type AccessTokenClaims struct {
+    UserID         string
+    Email          string
+    OrganizationID string
+    IssuedAt       time.Time
+    ExpiresAt      time.Time
+}
+
+// DecodeAccessToken decodes the claims of a JWT access token, without verifying its signature.
+func DecodeAccessToken(token string) (*AccessTokenClaims, error) {
+    parts := strings.Split(token, ".")
+    if len(parts) != 3 {
+        return nil, fmt.Errorf("invalid access token format")
+    }
+
+    payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+    if err != nil {
+        return nil, fmt.Errorf("invalid access token payload: %v", err)
+    }
+
+    var raw struct {
+        ID             string  `json:"id"`
+        Sub            string  `json:"sub"`
+        Email          string  `json:"email"`
+        OrganizationID string  `json:"organizationId"`
+        OrgID          string  `json:"orgId"`
+        Iat            float64 `json:"iat"`
+        Exp            float64 `json:"exp"`
+    }
+    if err := json.Unmarshal(payload, &raw); err != nil {
+        return nil, fmt.Errorf("invalid access token payload: %v", err)
+    }
+
+    claims := &AccessTokenClaims{
+        UserID:         raw.ID,
+        Email:          raw.Email,
+        OrganizationID: raw.OrganizationID,
+    }
+    if claims.UserID == "" {
+        claims.UserID = raw.Sub
+    }
+    if claims.OrganizationID == "" {
+        claims.OrganizationID = raw.OrgID
+    }
+    if raw.Iat > 0 {
+        claims.IssuedAt = time.Unix(int64(raw.Iat), 0).UTC()
+    }
+    if raw.Exp > 0 {
+        claims.ExpiresAt = time.Unix(int64(raw.Exp), 0).UTC()
+    }
+
+    return claims, nil
+}
+
+// GetAccessTokenClaims returns the claims of the access token the client is authenticated with.
+func (c *Client) GetAccessTokenClaims() (*AccessTokenClaims, error) {
+    if c.accessToken == "" {
+        return nil, fmt.Errorf("client is not authenticated")
+    }
+    return DecodeAccessToken(c.accessToken)
+}
+
+// GetUserIDFromToken returns the ID of the authenticated user.
+func (c *Client) GetUserIDFromToken() (string, error) {
+    claims, err := c.GetAccessTokenClaims()
+    if err != nil {
+        return "", err
+    }
+    if claims.UserID == "" {
+        return "", fmt.Errorf("user ID not found in access token")
+    }
+    return claims.UserID, nil
+}
+
+// GetUserEmailFromToken returns the email of the authenticated user, empty if the access token has no email claim.
+func (c *Client) GetUserEmailFromToken() (string, error) {
+    claims, err := c.GetAccessTokenClaims()
+    if err != nil {
+        return "", err
+    }
+    return claims.Email, nil
+}
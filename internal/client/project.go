@@ -112,6 +112,47 @@ func (c *Client) CreateProject(ctx context.Context, organizationID, projectName
     return connectProject, automateProject, nil
 }
 
+type CreateTeamProjectRequest struct {
+    Title            string `json:"title"`
+    IsConnectProject bool   `json:"isConnectProject"`
+}
+
+// CreateProjectInTeam creates a connect project inside an existing team, unlike CreateProject which creates a new team.
+func (c *Client) CreateProjectInTeam(ctx context.Context, teamID, projectName string) (*Project, error) {
+    url := fmt.Sprintf("%s/projects?teamId=%s", c.baseURL, teamID)
+
+    reqBody := CreateTeamProjectRequest{
+        Title:            projectName,
+        IsConnectProject: true,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create project in team %s with status code: %d", teamID, resp.StatusCode)
+    }
+
+    var project Project
+    err = json.NewDecoder(resp.Body).Decode(&project)
+    if err != nil {
+        return nil, err
+    }
+
+    return &project, nil
+}
+
 func (c *Client) GetProjects(ctx context.Context, teamID string) ([]Project, error) {
     // Use reasonable page size to get projects efficiently
     url := fmt.Sprintf("%s/projects?teamId=%s&size=9007199254740991", c.baseURL, teamID)
@@ -207,6 +248,41 @@ func (c *Client) UpdateProjectTitle(ctx context.Context, projectID, teamID, newT
     return &updatedProject, nil
 }
 
+// UpdateProjectHidden hides (or shows) a project in the dashboard, without deleting it.
+func (c *Client) UpdateProjectHidden(ctx context.Context, projectID, teamID string, hidden bool) (*Project, error) {
+    url := fmt.Sprintf("%s/projects/%s?teamId=%s", c.baseURL, projectID, teamID)
+
+    jsonBody, err := json.Marshal(map[string]bool{"isHidden": hidden})
+    if err != nil {
+        return nil, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to update project visibility with status code: %d", resp.StatusCode)
+    }
+
+    var updatedProject Project
+    err = json.NewDecoder(resp.Body).Decode(&updatedProject)
+    if err != nil {
+        return nil, err
+    }
+
+    return &updatedProject, nil
+}
+
 func (c *Client) DeleteProject(ctx context.Context, projectID, teamID string) error {
     url := fmt.Sprintf("%s/projects/%s?teamId=%s", c.baseURL, projectID, teamID)
     tflog.Debug(ctx, fmt.Sprintf("url to delete: %s", url))
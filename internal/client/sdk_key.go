@@ -4,8 +4,14 @@ package client
 import (
     "bytes"
     "context"
+    "crypto/rsa"
+    "crypto/sha256"
+    "crypto/x509"
+    "encoding/base64"
     "encoding/json"
+    "encoding/pem"
     "fmt"
+    "math/big"
     "net/http"
 )
 
@@ -118,3 +124,85 @@ func (c *Client) DeleteSDKKey(ctx context.Context, projectID, keyID string) erro
 
     return nil
 }
+
+// SDKKeySigningAlgorithm is the algorithm used to sign tokens with a paragon SDK key.
+const SDKKeySigningAlgorithm = "RS256"
+
+// JWK is the JSON Web Key representation of an SDK public key.
+type JWK struct {
+    Kty string `json:"kty"`
+    Kid string `json:"kid"`
+    Use string `json:"use"`
+    Alg string `json:"alg"`
+    N   string `json:"n"`
+    E   string `json:"e"`
+}
+
+// ParseRSAPublicKey parses a PEM encoded RSA public key (PKIX or PKCS#1).
+func ParseRSAPublicKey(publicKeyPEM string) (*rsa.PublicKey, error) {
+    block, _ := pem.Decode([]byte(publicKeyPEM))
+    if block == nil {
+        return nil, fmt.Errorf("invalid public key: no PEM block found")
+    }
+
+    if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+        return key, nil
+    }
+
+    key, err := x509.ParsePKIXPublicKey(block.Bytes)
+    if err != nil {
+        return nil, fmt.Errorf("invalid public key: %v", err)
+    }
+
+    rsaKey, ok := key.(*rsa.PublicKey)
+    if !ok {
+        return nil, fmt.Errorf("invalid public key: not an RSA key")
+    }
+
+    return rsaKey, nil
+}
+
+// PublicKeyToJWK converts a PEM encoded public key to a JWK, using its RFC 7638 thumbprint as the key ID.
+func PublicKeyToJWK(publicKeyPEM string) (*JWK, error) {
+    key, err := ParseRSAPublicKey(publicKeyPEM)
+    if err != nil {
+        return nil, err
+    }
+
+    n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+    e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+
+    // The thumbprint members must be in lexicographic order
+    thumbprint := sha256.Sum256([]byte(fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, e, n)))
+
+    return &JWK{
+        Kty: "RSA",
+        Kid: base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+        Use: "sig",
+        Alg: SDKKeySigningAlgorithm,
+        N:   n,
+        E:   e,
+    }, nil
+}
+
+// PublicKeysToJWKS encodes the given PEM encoded public keys as a JSON Web Key Set.
+func PublicKeysToJWKS(publicKeysPEM []string) (string, error) {
+    jwks := struct {
+        Keys []JWK `json:"keys"`
+    }{Keys: []JWK{}}
+
+    for _, publicKeyPEM := range publicKeysPEM {
+        jwk, err := PublicKeyToJWK(publicKeyPEM)
+        if err != nil {
+            return "", err
+        }
+        jwks.Keys = append(jwks.Keys, *jwk)
+    }
+
+    encoded, err := json.Marshal(jwks)
+    if err != nil {
+        return "", err
+    }
+
+    return string(encoded), nil
+}
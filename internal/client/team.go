@@ -2,6 +2,7 @@
 package client
 
 import (
+    "bytes"
     "context"
     "encoding/json"
     "fmt"
@@ -74,3 +75,107 @@ func (c *Client) GetTeamByID(ctx context.Context, teamID string) (*Team, error)
 
     return &team, nil
 }
+
+type CreateTeamRequest struct {
+    Name           string `json:"name"`
+    Website        string `json:"website,omitempty"`
+    OrganizationID string `json:"organizationId"`
+}
+
+type UpdateTeamRequest struct {
+    Name    string `json:"name"`
+    Website string `json:"website"`
+}
+
+func (c *Client) CreateTeam(ctx context.Context, organizationID, name, website string) (*Team, error) {
+    url := fmt.Sprintf("%s/teams?organizationId=%s", c.baseURL, organizationID)
+
+    reqBody := CreateTeamRequest{
+        Name:           name,
+        Website:        website,
+        OrganizationID: organizationID,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        return nil, fmt.Errorf("failed to create team with status code: %d", resp.StatusCode)
+    }
+
+    var team Team
+    err = json.NewDecoder(resp.Body).Decode(&team)
+    if err != nil {
+        return nil, err
+    }
+
+    return &team, nil
+}
+
+func (c *Client) UpdateTeam(ctx context.Context, teamID, name, website string) (*Team, error) {
+    url := fmt.Sprintf("%s/teams/%s", c.baseURL, teamID)
+
+    reqBody := UpdateTeamRequest{
+        Name:    name,
+        Website: website,
+    }
+    jsonBody, _ := json.Marshal(reqBody)
+
+    req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to update team with status code: %d", resp.StatusCode)
+    }
+
+    var team Team
+    err = json.NewDecoder(resp.Body).Decode(&team)
+    if err != nil {
+        return nil, err
+    }
+
+    return &team, nil
+}
+
+func (c *Client) DeleteTeam(ctx context.Context, teamID string) error {
+    url := fmt.Sprintf("%s/teams/%s", c.baseURL, teamID)
+
+    req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+    if err != nil {
+        return err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("failed to delete team with status code: %d", resp.StatusCode)
+    }
+
+    return nil
+}
@@ -29,6 +29,13 @@ type TeamInvite struct {
     Team         Team   `json:"team"`
 }
 
+// Statuses a team invite can be in.
+const (
+    TeamInviteStatusPending  = "PENDING"
+    TeamInviteStatusAccepted = "ACCEPTED"
+    TeamInviteStatusExpired  = "EXPIRED"
+)
+
 type InviteTeamMemberRequest struct {
     Role   string   `json:"role"`
     Emails []string `json:"emails"`
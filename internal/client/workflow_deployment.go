@@ -2,12 +2,20 @@
 package client
 
 import (
+    "bytes"
     "context"
     "encoding/json"
     "fmt"
     "net/http"
 )
 
+const (
+    WorkflowDeploymentStatusDeploying   = "DEPLOYING"
+    WorkflowDeploymentStatusDeployed    = "DEPLOYED"
+    WorkflowDeploymentStatusUndeploying = "UNDEPLOYING"
+    WorkflowDeploymentStatusUndeployed  = "UNDEPLOYED"
+)
+
 type WorkflowDeployment struct {
     ID       string `json:"id"`
     Status   string `json:"status"`
@@ -15,9 +23,12 @@ type WorkflowDeployment struct {
 }
 
 type WorkflowMigration struct {
-    ID         string              `json:"id"`
-    WorkflowID string              `json:"workflowId"`
-    Deployment WorkflowDeployment `json:"deployment"`
+    ID              string             `json:"id"`
+    DateCreated     string             `json:"dateCreated"`
+    WorkflowID      string             `json:"workflowId"`
+    WorkflowVersion int                `json:"workflowVersion"`
+    UserID          string             `json:"userId"`
+    Deployment      WorkflowDeployment `json:"deployment"`
 }
 
 
@@ -53,6 +64,45 @@ type WorkflowMigration struct {
         return response.ID, nil
     }
 
+// CreateWorkflowDeploymentFromMigration deploys the workflow as it was in a previous migration, to roll it back.
+func (c *Client) CreateWorkflowDeploymentFromMigration(ctx context.Context, projectID, workflowID, migrationID string) (string, error) {
+    url := fmt.Sprintf("%s/projects/%s/workflows/%s/deployments", c.baseURL, projectID, workflowID)
+
+    jsonBody, err := json.Marshal(map[string]string{"migrationId": migrationID})
+    if err != nil {
+        return "", err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+    if err != nil {
+        return "", err
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return "", err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusCreated {
+        errorMessage := formatErrorMessage(resp)
+        return "", fmt.Errorf("%s, status code: %d", errorMessage, resp.StatusCode)
+    }
+
+    var response struct {
+        ID     string `json:"id"`
+        Status string `json:"status"`
+    }
+    err = json.NewDecoder(resp.Body).Decode(&response)
+    if err != nil {
+        return "", err
+    }
+
+    return response.ID, nil
+}
+
 func (c *Client) GetWorkflowDeployment(ctx context.Context, projectID, deploymentID string) (*WorkflowDeployment, error) {
     url := fmt.Sprintf("%s/projects/%s/deployments/%s", c.baseURL, projectID, deploymentID)
 
@@ -107,7 +157,37 @@ func (c *Client) DeleteWorkflowDeployment(ctx context.Context, projectID, workfl
     return nil
 }
 
-func (c *Client) GetLatestWorkflowMigration(ctx context.Context, projectID, workflowID string) (*WorkflowMigration, error) {
+// GetWorkflowMigrations returns the migration history of a workflow, one migration per deployment.
+func (c *Client) GetWorkflowMigrations(ctx context.Context, projectID, workflowID string) ([]WorkflowMigration, error) {
+    url := fmt.Sprintf("%s/projects/%s/workflows/%s/migrations", c.baseURL, projectID, workflowID)
+
+    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to get workflow migrations with status code: %d", resp.StatusCode)
+    }
+
+    var migrations []WorkflowMigration
+    err = json.NewDecoder(resp.Body).Decode(&migrations)
+    if err != nil {
+        return nil, err
+    }
+
+    return migrations, nil
+}
+
+// GetLatestWorkflowMigrations returns the latest migration of every deployed workflow in the project.
+func (c *Client) GetLatestWorkflowMigrations(ctx context.Context, projectID string) ([]WorkflowMigration, error) {
     url := fmt.Sprintf("%s/projects/%s/workflows/migrations/latest", c.baseURL, projectID)
 
     req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -132,6 +212,17 @@ func (c *Client) GetLatestWorkflowMigration(ctx context.Context, projectID, work
         return nil, err
     }
 
+    return migrations, nil
+}
+
+// GetLatestWorkflowMigration returns the latest migration of a workflow, or nil if it was never deployed.
+// The latest migrations endpoint is project wide, so the workflow is picked from its result.
+func (c *Client) GetLatestWorkflowMigration(ctx context.Context, projectID, workflowID string) (*WorkflowMigration, error) {
+    migrations, err := c.GetLatestWorkflowMigrations(ctx, projectID)
+    if err != nil {
+        return nil, err
+    }
+
     for _, migration := range migrations {
         if migration.WorkflowID == workflowID {
             return &migration, nil
@@ -139,4 +230,4 @@ func (c *Client) GetLatestWorkflowMigration(ctx context.Context, projectID, work
     }
 
     return nil, nil
-}
\ No newline at end of file
+}
@@ -41,7 +41,11 @@ type WorkflowsResponse struct {
 }
 
 func (c *Client) GetWorkflows(ctx context.Context, projectID, integrationID string) ([]Workflow, error) {
-    url := fmt.Sprintf("%s/projects/%s/workflows?includeDeleted=false&integrationId=%s", c.baseURL, projectID, integrationID)
+    url := fmt.Sprintf("%s/projects/%s/workflows?includeDeleted=false", c.baseURL, projectID)
+    // Without an integration, all the workflows of the project are returned
+    if integrationID != "" {
+        url += "&integrationId=" + integrationID
+    }
 
     req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
     if err != nil {
@@ -66,4 +70,45 @@ func (c *Client) GetWorkflows(ctx context.Context, projectID, integrationID stri
     }
 
     return workflowsResponse.Items, nil
-}
\ No newline at end of file
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, projectID, workflowID string) (*Workflow, error) {
+    url := fmt.Sprintf("%s/projects/%s/workflows/%s", c.baseURL, projectID, workflowID)
+
+    req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+    resp, err := c.httpClient.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("failed to get workflow with status code: %d", resp.StatusCode)
+    }
+
+    var workflow Workflow
+    err = json.NewDecoder(resp.Body).Decode(&workflow)
+    if err != nil {
+        return nil, err
+    }
+
+    return &workflow, nil
+}
+
+// LoadWorkflowSteps returns the workflow with its steps, fetching it when it was listed without them.
+func (c *Client) LoadWorkflowSteps(ctx context.Context, projectID string, workflow Workflow) (Workflow, error) {
+    if len(workflow.Steps) > 0 {
+        return workflow, nil
+    }
+
+    fullWorkflow, err := c.GetWorkflow(ctx, projectID, workflow.ID)
+    if err != nil {
+        return workflow, err
+    }
+    return *fullWorkflow, nil
+}
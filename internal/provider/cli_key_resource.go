@@ -37,6 +37,7 @@ type cliKeyResourceModel struct {
     OrganizationID types.String `tfsdk:"organization_id"`
     KeyName        types.String `tfsdk:"name"`
     Key            types.String `tfsdk:"key"`
+    OnDestroy      types.String `tfsdk:"on_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -81,6 +82,7 @@ func (r *cliKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
                 Computed:    true,
                 Sensitive:   true,
             },
+            "on_destroy": onDestroyAttribute("CLI key", "deletes the key", ""),
         },
     }
 }
@@ -192,6 +194,8 @@ func (r *cliKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
         return
     }
 
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
     organizationID := state.OrganizationID.ValueString()
     keyID := state.ID.ValueString()
 
@@ -282,6 +286,10 @@ func (r *cliKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
         return
     }
 
+    if abandonOnDestroy(state.OnDestroy, "CLI key", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     organizationID := state.OrganizationID.ValueString()
     keyID := state.ID.ValueString()
 
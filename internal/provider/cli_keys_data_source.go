@@ -0,0 +1,199 @@
+package provider
+
+import (
+    "context"
+    "math"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &cliKeysDataSource{}
+    _ datasource.DataSourceWithConfigure = &cliKeysDataSource{}
+)
+
+// NewCLIKeysDataSource is a helper function to simplify the provider implementation.
+func NewCLIKeysDataSource() datasource.DataSource {
+    return &cliKeysDataSource{}
+}
+
+// cliKeysDataSource is the data source implementation.
+type cliKeysDataSource struct {
+    client *client.Client
+}
+
+// cliKeysDataSourceModel maps the data source schema data.
+type cliKeysDataSourceModel struct {
+    OrganizationID types.String  `tfsdk:"organization_id"`
+    UserID         types.String  `tfsdk:"user_id"`
+    UnusedForDays  types.Int64   `tfsdk:"unused_for_days"`
+    Keys           []cliKeyModel `tfsdk:"keys"`
+}
+
+type cliKeyModel struct {
+    ID                types.String `tfsdk:"id"`
+    Name              types.String `tfsdk:"name"`
+    Suffix            types.String `tfsdk:"suffix"`
+    UserID            types.String `tfsdk:"user_id"`
+    DateCreated       types.String `tfsdk:"date_created"`
+    DateUpdated       types.String `tfsdk:"date_updated"`
+    DateLastUsed      types.String `tfsdk:"date_last_used"`
+    DaysSinceLastUsed types.Int64  `tfsdk:"days_since_last_used"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *cliKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *cliKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_cli_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *cliKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the list of CLI keys of an organization, with their last usage.",
+        Attributes: map[string]schema.Attribute{
+            "organization_id": schema.StringAttribute{
+                Description: "The ID of the organization.",
+                Required:    true,
+            },
+            "user_id": schema.StringAttribute{
+                Description: "Only return the CLI keys of this user.",
+                Optional:    true,
+            },
+            "unused_for_days": schema.Int64Attribute{
+                Description: "Only return the CLI keys that were not used for at least this number of days. Keys that were never used count from their creation date.",
+                Optional:    true,
+                Validators: []validator.Int64{
+                    int64validator.AtLeast(0),
+                },
+            },
+            "keys": schema.ListNestedAttribute{
+                Description: "The list of CLI keys.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "Identifier of the CLI key.",
+                            Computed:    true,
+                        },
+                        "name": schema.StringAttribute{
+                            Description: "Name of the CLI key.",
+                            Computed:    true,
+                        },
+                        "suffix": schema.StringAttribute{
+                            Description: "Last characters of the CLI key.",
+                            Computed:    true,
+                        },
+                        "user_id": schema.StringAttribute{
+                            Description: "Identifier of the user owning the CLI key.",
+                            Computed:    true,
+                        },
+                        "date_created": schema.StringAttribute{
+                            Description: "The creation date of the CLI key.",
+                            Computed:    true,
+                        },
+                        "date_updated": schema.StringAttribute{
+                            Description: "The last update date of the CLI key.",
+                            Computed:    true,
+                        },
+                        "date_last_used": schema.StringAttribute{
+                            Description: "The last date the CLI key was used, empty if it was never used.",
+                            Computed:    true,
+                        },
+                        "days_since_last_used": schema.Int64Attribute{
+                            Description: "Number of full days since the CLI key was last used (or created, if it was never used).",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cliKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state cliKeysDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    cliKeys, err := d.client.GetCLIKeys(ctx, state.OrganizationID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read CLI Keys",
+            err.Error(),
+        )
+        return
+    }
+
+    now := time.Now()
+    keyModels := []cliKeyModel{}
+    for _, cliKey := range cliKeys {
+        if !state.UserID.IsNull() && cliKey.UserID != state.UserID.ValueString() {
+            continue
+        }
+
+        // Keys that were never used are counted from their creation
+        daysSinceLastUsed := daysSince(cliKey.DateLastUsed, cliKey.DateCreated, now)
+        if !idleForAtLeast(daysSinceLastUsed, state.UnusedForDays) {
+            continue
+        }
+
+        keyModels = append(keyModels, cliKeyModel{
+            ID:                types.StringValue(cliKey.ID),
+            Name:              types.StringValue(cliKey.Name),
+            Suffix:            types.StringValue(cliKey.Suffix),
+            UserID:            types.StringValue(cliKey.UserID),
+            DateCreated:       types.StringValue(cliKey.DateCreated),
+            DateUpdated:       types.StringValue(cliKey.DateUpdated),
+            DateLastUsed:      types.StringValue(cliKey.DateLastUsed),
+            DaysSinceLastUsed: daysSinceLastUsed,
+        })
+    }
+
+    state.Keys = keyModels
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// daysSince returns the number of full days from the RFC3339 date (or fallback, when date is empty) to now,
+// null when the date cannot be parsed.
+func daysSince(date, fallback string, now time.Time) types.Int64 {
+    if date == "" {
+        date = fallback
+    }
+    t, err := time.Parse(time.RFC3339, date)
+    if err != nil {
+        return types.Int64Null()
+    }
+    return types.Int64Value(int64(math.Floor(now.Sub(t).Hours() / 24)))
+}
+
+// idleForAtLeast reports whether an item idle for days passes a minimum idle days filter (null when unset).
+// Items with an unknown idle time are kept, so they are reviewed rather than missed.
+func idleForAtLeast(days, minDays types.Int64) bool {
+    return minDays.IsNull() || days.IsNull() || days.ValueInt64() >= minDays.ValueInt64()
+}
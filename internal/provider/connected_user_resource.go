@@ -0,0 +1,306 @@
+package provider
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource              = &connectedUserResource{}
+    _ resource.ResourceWithConfigure = &connectedUserResource{}
+)
+
+// NewConnectedUserResource is a helper function to simplify the provider implementation.
+func NewConnectedUserResource() resource.Resource {
+    return &connectedUserResource{}
+}
+
+// connectedUserResource is the resource implementation.
+type connectedUserResource struct {
+    client *client.Client
+}
+
+// connectedUserResourceModel maps the resource schema data.
+type connectedUserResourceModel struct {
+    ID                 types.String      `tfsdk:"id"`
+    ProjectID          types.String      `tfsdk:"project_id"`
+    UserID             types.String      `tfsdk:"user_id"`
+    Metadata           map[string]string `tfsdk:"metadata"`
+    DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+    OnDestroy          types.String      `tfsdk:"on_destroy"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *connectedUserResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *connectedUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_connected_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *connectedUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages the metadata of a connected user, and disconnects its integrations when destroyed.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the connected user in Paragon.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "user_id": schema.StringAttribute{
+                Description: "The user ID the application signed the user token with. The user must have connected to Paragon already.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "metadata": schema.MapAttribute{
+                Description: "Metadata values pinned on the connected user. Other metadata keys are left untouched.",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "deletion_protection": deletionProtectionAttribute("connected user"),
+            "on_destroy":          onDestroyAttribute("connected user", "disconnects all its integrations and wipes its metadata", ""),
+        },
+    }
+}
+
+// applyConnectedUserMetadata pins the planned metadata on the connected user, removing the keys previously pinned and no longer planned.
+func (r *connectedUserResource) applyConnectedUserMetadata(ctx context.Context, projectID string, connectedUser *client.ConnectedUser, planned, prior map[string]string) error {
+    meta := make(map[string]any)
+    for key, value := range connectedUser.Meta {
+        meta[key] = value
+    }
+    for key := range prior {
+        delete(meta, key)
+    }
+    for key, value := range planned {
+        meta[key] = value
+    }
+
+    _, err := r.client.UpdateConnectedUserMetadata(ctx, projectID, connectedUser.ID, meta)
+    return err
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *connectedUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan connectedUserResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := plan.ProjectID.ValueString()
+
+    // Connected users are created by Paragon when they first connect, they can only be looked up
+    connectedUsers, err := r.client.GetConnectedUsers(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading connected users",
+            "Could not read the connected users of the project, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    var connectedUser *client.ConnectedUser
+    for i := range connectedUsers {
+        if connectedUsers[i].UserID == plan.UserID.ValueString() {
+            connectedUser = &connectedUsers[i]
+            break
+        }
+    }
+    if connectedUser == nil {
+        resp.Diagnostics.AddError(
+            "Connected user not found",
+            fmt.Sprintf("No connected user with user ID %s found in project %s. Connected users are created when they first connect an integration.",
+                plan.UserID.ValueString(), projectID),
+        )
+        return
+    }
+
+    err = r.applyConnectedUserMetadata(ctx, projectID, connectedUser, plan.Metadata, nil)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating connected user metadata",
+            "Could not update connected user metadata, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    plan.ID = types.StringValue(connectedUser.ID)
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *connectedUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state connectedUserResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    connectedUser, err := r.client.GetConnectedUser(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        if strings.Contains(err.Error(), "status code: 404") {
+            resp.State.RemoveResource(ctx)
+        } else {
+            resp.Diagnostics.AddError(
+                "Error retrieving connected user",
+                "Could not retrieve connected user, unexpected error: "+err.Error(),
+            )
+        }
+        return
+    }
+
+    // Only the pinned keys are refreshed, so drift on them shows in the plan
+    if state.Metadata != nil {
+        metadata := make(map[string]string)
+        for key := range state.Metadata {
+            if value, ok := connectedUser.Meta[key]; ok {
+                metadata[key] = connectedUserMetadataValue(value)
+            }
+        }
+        state.Metadata = metadata
+    }
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *connectedUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan connectedUserResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state connectedUserResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+    plan.ID = state.ID
+
+    connectedUser, err := r.client.GetConnectedUser(ctx, projectID, state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error retrieving connected user",
+            "Could not retrieve connected user, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    err = r.applyConnectedUserMetadata(ctx, projectID, connectedUser, plan.Metadata, state.Metadata)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating connected user metadata",
+            "Could not update connected user metadata, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *connectedUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state connectedUserResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "connected user", state.UserID.ValueString())...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "connected user", state.UserID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+
+    connectedUser, err := r.client.GetConnectedUser(ctx, projectID, state.ID.ValueString())
+    if err != nil {
+        if strings.Contains(err.Error(), "status code: 404") {
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error retrieving connected user",
+            "Could not retrieve connected user, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    // Disconnect every integration of the user, not only the ones connected when it was created
+    for _, integration := range connectedUser.Integrations {
+        if integration.CredentialID == "" {
+            continue
+        }
+        err := r.client.DisconnectConnectedUserCredential(ctx, projectID, connectedUser.ID, integration.CredentialID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Error disconnecting connected user",
+                fmt.Sprintf("Could not disconnect the %s integration of the user, unexpected error: %s", integration.Type, err.Error()),
+            )
+            return
+        }
+    }
+
+    _, err = r.client.UpdateConnectedUserMetadata(ctx, projectID, connectedUser.ID, map[string]any{})
+    if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+        resp.Diagnostics.AddError(
+            "Error updating connected user metadata",
+            "Could not wipe connected user metadata, unexpected error: "+err.Error(),
+        )
+        return
+    }
+}
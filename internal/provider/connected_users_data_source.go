@@ -0,0 +1,291 @@
+package provider
+
+import (
+    "context"
+    "encoding/json"
+    "sort"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &connectedUsersDataSource{}
+    _ datasource.DataSourceWithConfigure = &connectedUsersDataSource{}
+)
+
+// NewConnectedUsersDataSource is a helper function to simplify the provider implementation.
+func NewConnectedUsersDataSource() datasource.DataSource {
+    return &connectedUsersDataSource{}
+}
+
+// connectedUsersDataSource is the data source implementation.
+type connectedUsersDataSource struct {
+    client *client.Client
+}
+
+// connectedUsersDataSourceModel maps the data source schema data.
+type connectedUsersDataSourceModel struct {
+    ProjectID        types.String         `tfsdk:"project_id"`
+    UserID           types.String         `tfsdk:"user_id"`
+    IntegrationType  types.String         `tfsdk:"integration_type"`
+    CredentialStatus types.String         `tfsdk:"credential_status"`
+    Metadata         map[string]string    `tfsdk:"metadata"`
+    InactiveForDays  types.Int64          `tfsdk:"inactive_for_days"`
+    UserIDs          []types.String       `tfsdk:"user_ids"`
+    Users            []connectedUserModel `tfsdk:"users"`
+}
+
+type connectedUserModel struct {
+    ID                  types.String                    `tfsdk:"id"`
+    UserID              types.String                    `tfsdk:"user_id"`
+    DateCreated         types.String                    `tfsdk:"date_created"`
+    DateLastActive      types.String                    `tfsdk:"date_last_active"`
+    DaysSinceLastActive types.Int64                     `tfsdk:"days_since_last_active"`
+    Metadata            types.String                    `tfsdk:"metadata"`
+    Integrations        []connectedUserIntegrationModel `tfsdk:"integrations"`
+}
+
+type connectedUserIntegrationModel struct {
+    IntegrationID    types.String `tfsdk:"integration_id"`
+    Type             types.String `tfsdk:"type"`
+    CredentialID     types.String `tfsdk:"credential_id"`
+    CredentialStatus types.String `tfsdk:"credential_status"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *connectedUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *connectedUsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_connected_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *connectedUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the connected users of a project, optionally filtered.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "user_id": schema.StringAttribute{
+                Description: "Only return the connected user with this user ID.",
+                Optional:    true,
+            },
+            "integration_type": schema.StringAttribute{
+                Description: "Only return the users connected to this integration type.",
+                Optional:    true,
+            },
+            "credential_status": schema.StringAttribute{
+                Description: "Only return the users having a credential with this status (e.g. INVALID), of integration_type when set.",
+                Optional:    true,
+            },
+            "metadata": schema.MapAttribute{
+                Description: "Only return the users whose metadata has all these values.",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "inactive_for_days": schema.Int64Attribute{
+                Description: "Only return the users that were not active for at least this number of days.",
+                Optional:    true,
+                Validators: []validator.Int64{
+                    int64validator.AtLeast(0),
+                },
+            },
+            "user_ids": schema.ListAttribute{
+                Description: "The user IDs of the matching connected users, sorted.",
+                Computed:    true,
+                ElementType: types.StringType,
+            },
+            "users": schema.ListNestedAttribute{
+                Description: "The matching connected users, sorted by user ID.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "The ID of the connected user in Paragon.",
+                            Computed:    true,
+                        },
+                        "user_id": schema.StringAttribute{
+                            Description: "The user ID the application signed the user token with.",
+                            Computed:    true,
+                        },
+                        "date_created": schema.StringAttribute{
+                            Description: "The date the user first connected.",
+                            Computed:    true,
+                        },
+                        "date_last_active": schema.StringAttribute{
+                            Description: "The date the user was last active.",
+                            Computed:    true,
+                        },
+                        "days_since_last_active": schema.Int64Attribute{
+                            Description: "The number of days since the user was last active (or connected, when never active).",
+                            Computed:    true,
+                        },
+                        "metadata": schema.StringAttribute{
+                            Description: "The metadata of the user, JSON encoded.",
+                            Computed:    true,
+                        },
+                        "integrations": schema.ListNestedAttribute{
+                            Description: "The integrations the user connected.",
+                            Computed:    true,
+                            NestedObject: schema.NestedAttributeObject{
+                                Attributes: map[string]schema.Attribute{
+                                    "integration_id": schema.StringAttribute{
+                                        Description: "The ID of the integration.",
+                                        Computed:    true,
+                                    },
+                                    "type": schema.StringAttribute{
+                                        Description: "The type of the integration.",
+                                        Computed:    true,
+                                    },
+                                    "credential_id": schema.StringAttribute{
+                                        Description: "The ID of the credential of the user.",
+                                        Computed:    true,
+                                    },
+                                    "credential_status": schema.StringAttribute{
+                                        Description: "The status of the credential (e.g. VALID, INVALID).",
+                                        Computed:    true,
+                                    },
+                                },
+                            },
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// connectedUserMetadataValue returns a metadata value as compared with the metadata filter, JSON encoded unless it is a string.
+func connectedUserMetadataValue(value any) string {
+    if s, ok := value.(string); ok {
+        return s
+    }
+    encoded, err := json.Marshal(value)
+    if err != nil {
+        return ""
+    }
+    return string(encoded)
+}
+
+// matchesConnectedUserFilters reports whether a connected user matches the integration, credential and metadata filters.
+func matchesConnectedUserFilters(user client.ConnectedUser, filters *connectedUsersDataSourceModel) bool {
+    if !filters.UserID.IsNull() && user.UserID != filters.UserID.ValueString() {
+        return false
+    }
+
+    for key, expected := range filters.Metadata {
+        value, ok := user.Meta[key]
+        if !ok || connectedUserMetadataValue(value) != expected {
+            return false
+        }
+    }
+
+    if filters.IntegrationType.IsNull() && filters.CredentialStatus.IsNull() {
+        return true
+    }
+    for _, integration := range user.Integrations {
+        if !filters.IntegrationType.IsNull() && integration.Type != filters.IntegrationType.ValueString() {
+            continue
+        }
+        if !filters.CredentialStatus.IsNull() && integration.CredentialStatus != filters.CredentialStatus.ValueString() {
+            continue
+        }
+        return true
+    }
+    return false
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *connectedUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state connectedUsersDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    connectedUsers, err := d.client.GetConnectedUsers(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Connected Users",
+            err.Error(),
+        )
+        return
+    }
+
+    sort.Slice(connectedUsers, func(i, j int) bool {
+        return connectedUsers[i].UserID < connectedUsers[j].UserID
+    })
+
+    now := time.Now()
+    state.UserIDs = []types.String{}
+    state.Users = []connectedUserModel{}
+    for _, user := range connectedUsers {
+        if !matchesConnectedUserFilters(user, &state) {
+            continue
+        }
+
+        // Users that were never active are counted from their connection
+        daysSinceLastActive := daysSince(user.DateLastActive, user.DateCreated, now)
+        if !idleForAtLeast(daysSinceLastActive, state.InactiveForDays) {
+            continue
+        }
+
+        meta := user.Meta
+        if meta == nil {
+            meta = map[string]any{}
+        }
+        metadata, err := json.Marshal(meta)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Connected Users",
+                "Could not encode the metadata of user "+user.UserID+": "+err.Error(),
+            )
+            return
+        }
+
+        integrations := []connectedUserIntegrationModel{}
+        for _, integration := range user.Integrations {
+            integrations = append(integrations, connectedUserIntegrationModel{
+                IntegrationID:    types.StringValue(integration.IntegrationID),
+                Type:             types.StringValue(integration.Type),
+                CredentialID:     types.StringValue(integration.CredentialID),
+                CredentialStatus: types.StringValue(integration.CredentialStatus),
+            })
+        }
+
+        state.UserIDs = append(state.UserIDs, types.StringValue(user.UserID))
+        state.Users = append(state.Users, connectedUserModel{
+            ID:                  types.StringValue(user.ID),
+            UserID:              types.StringValue(user.UserID),
+            DateCreated:         types.StringValue(user.DateCreated),
+            DateLastActive:      types.StringValue(user.DateLastActive),
+            DaysSinceLastActive: daysSinceLastActive,
+            Metadata:            types.StringValue(string(metadata)),
+            Integrations:        integrations,
+        })
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+    "context"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &currentUserDataSource{}
+    _ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+)
+
+// NewCurrentUserDataSource is a helper function to simplify the provider implementation.
+func NewCurrentUserDataSource() datasource.DataSource {
+    return &currentUserDataSource{}
+}
+
+// currentUserDataSource is the data source implementation.
+type currentUserDataSource struct {
+    client *client.Client
+}
+
+// currentUserDataSourceModel maps the data source schema data.
+type currentUserDataSourceModel struct {
+    ID             types.String `tfsdk:"id"`
+    Email          types.String `tfsdk:"email"`
+    OrganizationID types.String `tfsdk:"organization_id"`
+    IssuedAt       types.String `tfsdk:"issued_at"`
+    ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *currentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the identity of the user the provider is authenticated as.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the user.",
+                Computed:    true,
+            },
+            "email": schema.StringAttribute{
+                Description: "Email of the user.",
+                Computed:    true,
+            },
+            "organization_id": schema.StringAttribute{
+                Description: "Identifier of the organization in the access token, empty if the token does not carry one.",
+                Computed:    true,
+            },
+            "issued_at": schema.StringAttribute{
+                Description: "The date the access token was issued at (RFC3339).",
+                Computed:    true,
+            },
+            "expires_at": schema.StringAttribute{
+                Description: "The date the access token expires at (RFC3339).",
+                Computed:    true,
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *currentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    claims, err := d.client.GetAccessTokenClaims()
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Current User",
+            err.Error(),
+        )
+        return
+    }
+
+    if claims.Email == "" {
+        resp.Diagnostics.AddWarning(
+            "User email not found",
+            "The access token has no email claim, email is empty.",
+        )
+    }
+
+    state := currentUserDataSourceModel{
+        ID:             types.StringValue(claims.UserID),
+        Email:          types.StringValue(claims.Email),
+        OrganizationID: types.StringValue(claims.OrganizationID),
+        IssuedAt:       types.StringValue(formatTokenTime(claims.IssuedAt)),
+        ExpiresAt:      types.StringValue(formatTokenTime(claims.ExpiresAt)),
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// formatTokenTime formats a token claim time, leaving it empty when the claim is missing.
+func formatTokenTime(t time.Time) string {
+    if t.IsZero() {
+        return ""
+    }
+    return t.Format(time.RFC3339)
+}
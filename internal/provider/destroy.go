@@ -0,0 +1,97 @@
+package provider
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// Values of the on_destroy attribute
+const (
+    onDestroyDelete     = "delete"
+    onDestroyDeactivate = "deactivate"
+    onDestroyAbandon    = "abandon"
+)
+
+// deletionProtectionAttribute returns the deletion_protection attribute of a resource, described by its noun (e.g. "project").
+func deletionProtectionAttribute(noun string) schema.BoolAttribute {
+    return schema.BoolAttribute{
+        Description: fmt.Sprintf("Prevents the %s from being destroyed. It must be set to false in a prior apply before the %s can be destroyed or replaced. (Default = false)", noun, noun),
+        Optional:    true,
+        Computed:    true,
+        Default:     booldefault.StaticBool(false),
+    }
+}
+
+// checkDeletionProtection fails the destroy of a resource whose deletion_protection is on in the state.
+func checkDeletionProtection(deletionProtection types.Bool, resourceType, id string) diag.Diagnostics {
+    var diags diag.Diagnostics
+    if deletionProtection.ValueBool() {
+        diags.AddAttributeError(
+            path.Root("deletion_protection"),
+            "Deletion protection is enabled",
+            fmt.Sprintf("The %s %s cannot be destroyed while deletion_protection is true. "+
+                "Set deletion_protection = false and apply, then destroy it.", resourceType, id),
+        )
+    }
+    return diags
+}
+
+// onDestroyAttribute returns the on_destroy attribute of a resource, described by its noun.
+// deletion and deactivation describe what the delete and deactivate actions do, empty when the resource does not support them.
+// The default is delete, or deactivate for resources that cannot be deleted.
+func onDestroyAttribute(noun, deletion, deactivation string) schema.StringAttribute {
+    var actions, descriptions []string
+    if deletion != "" {
+        actions = append(actions, onDestroyDelete)
+        descriptions = append(descriptions, fmt.Sprintf("`%s` %s", onDestroyDelete, deletion))
+    }
+    if deactivation != "" {
+        actions = append(actions, onDestroyDeactivate)
+        descriptions = append(descriptions, fmt.Sprintf("`%s` %s", onDestroyDeactivate, deactivation))
+    }
+    actions = append(actions, onDestroyAbandon)
+    descriptions = append(descriptions, fmt.Sprintf("`%s` only removes it from the state", onDestroyAbandon))
+
+    return schema.StringAttribute{
+        Description: fmt.Sprintf("What happens to the %s when it is destroyed: %s. (Default = `%s`)", noun, strings.Join(descriptions, ", "), actions[0]),
+        Optional:    true,
+        Computed:    true,
+        Default:     stringdefault.StaticString(actions[0]),
+        Validators: []validator.String{
+            stringvalidator.OneOf(actions...),
+        },
+    }
+}
+
+// defaultDestroyAttributes fills the deletion_protection (if the resource has it) and on_destroy of a state written before
+// they existed with their defaults, so upgrading the provider does not show a diff on them.
+func defaultDestroyAttributes(deletionProtection *types.Bool, onDestroy *types.String, onDestroyDefault string) {
+    if deletionProtection != nil && deletionProtection.IsNull() {
+        *deletionProtection = types.BoolValue(false)
+    }
+    if onDestroy.IsNull() {
+        *onDestroy = types.StringValue(onDestroyDefault)
+    }
+}
+
+// abandonOnDestroy reports whether a destroyed resource should only be removed from the state, warning that it still exists.
+func abandonOnDestroy(onDestroy types.String, resourceType, id string, diags *diag.Diagnostics) bool {
+    if onDestroy.ValueString() != onDestroyAbandon {
+        return false
+    }
+
+    diags.AddWarning(
+        "Resource abandoned",
+        fmt.Sprintf("The %s %s was removed from the Terraform state but still exists in Paragon, as on_destroy is %q.", resourceType, id, onDestroyAbandon),
+    )
+    return true
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+    "context"
+    "sort"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &environmentSecretReferencesDataSource{}
+    _ datasource.DataSourceWithConfigure = &environmentSecretReferencesDataSource{}
+)
+
+// NewEnvironmentSecretReferencesDataSource is a helper function to simplify the provider implementation.
+func NewEnvironmentSecretReferencesDataSource() datasource.DataSource {
+    return &environmentSecretReferencesDataSource{}
+}
+
+// environmentSecretReferencesDataSource is the data source implementation.
+type environmentSecretReferencesDataSource struct {
+    client *client.Client
+}
+
+// environmentSecretReferencesDataSourceModel maps the data source schema data.
+type environmentSecretReferencesDataSourceModel struct {
+    ProjectID types.String                       `tfsdk:"project_id"`
+    Secrets   []environmentSecretReferencesModel `tfsdk:"secrets"`
+}
+
+type environmentSecretReferencesModel struct {
+    Key        types.String                      `tfsdk:"key"`
+    Referenced types.Bool                        `tfsdk:"referenced"`
+    References []environmentSecretReferenceModel `tfsdk:"references"`
+}
+
+type environmentSecretReferenceModel struct {
+    WorkflowID          types.String `tfsdk:"workflow_id"`
+    WorkflowDescription types.String `tfsdk:"workflow_description"`
+    IntegrationID       types.String `tfsdk:"integration_id"`
+    StepID              types.String `tfsdk:"step_id"`
+    StepType            types.String `tfsdk:"step_type"`
+    Deployed            types.Bool   `tfsdk:"deployed"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *environmentSecretReferencesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *environmentSecretReferencesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_environment_secret_references"
+}
+
+// Schema defines the schema for the data source.
+func (d *environmentSecretReferencesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Reports, for each environment secret of a project, the workflow steps referencing it.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "secrets": schema.ListNestedAttribute{
+                Description: "The environment secrets of the project, sorted by key.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "key": schema.StringAttribute{
+                            Description: "Key of the environment secret.",
+                            Computed:    true,
+                        },
+                        "referenced": schema.BoolAttribute{
+                            Description: "Indicates if at least one workflow step references the secret.",
+                            Computed:    true,
+                        },
+                        "references": schema.ListNestedAttribute{
+                            Description: "The workflow steps referencing the secret.",
+                            Computed:    true,
+                            NestedObject: schema.NestedAttributeObject{
+                                Attributes: map[string]schema.Attribute{
+                                    "workflow_id": schema.StringAttribute{
+                                        Description: "The ID of the workflow.",
+                                        Computed:    true,
+                                    },
+                                    "workflow_description": schema.StringAttribute{
+                                        Description: "The description of the workflow.",
+                                        Computed:    true,
+                                    },
+                                    "integration_id": schema.StringAttribute{
+                                        Description: "The ID of the integration of the workflow.",
+                                        Computed:    true,
+                                    },
+                                    "step_id": schema.StringAttribute{
+                                        Description: "The ID of the step.",
+                                        Computed:    true,
+                                    },
+                                    "step_type": schema.StringAttribute{
+                                        Description: "The type of the step.",
+                                        Computed:    true,
+                                    },
+                                    "deployed": schema.BoolAttribute{
+                                        Description: "Indicates if the workflow is deployed.",
+                                        Computed:    true,
+                                    },
+                                },
+                            },
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *environmentSecretReferencesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state environmentSecretReferencesDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    references, deployed, err := environmentSecretReferences(ctx, d.client, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Environment Secret References",
+            err.Error(),
+        )
+        return
+    }
+
+    state.Secrets = []environmentSecretReferencesModel{}
+    for _, key := range sortedKeys(references) {
+        secret := environmentSecretReferencesModel{
+            Key:        types.StringValue(key),
+            Referenced: types.BoolValue(len(references[key]) > 0),
+            References: []environmentSecretReferenceModel{},
+        }
+        for _, reference := range references[key] {
+            secret.References = append(secret.References, environmentSecretReferenceModel{
+                WorkflowID:          types.StringValue(reference.WorkflowID),
+                WorkflowDescription: types.StringValue(reference.WorkflowDescription),
+                IntegrationID:       types.StringValue(reference.IntegrationID),
+                StepID:              types.StringValue(reference.StepID),
+                StepType:            types.StringValue(reference.StepType),
+                Deployed:            types.BoolValue(deployed[reference.WorkflowID]),
+            })
+        }
+        state.Secrets = append(state.Secrets, secret)
+    }
+
+    // Set state
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// environmentSecretReferences returns the workflow steps referencing each secret of the project, keyed by secret key,
+// and the IDs of the deployed workflows.
+func environmentSecretReferences(ctx context.Context, c *client.Client, projectID string) (map[string][]client.EnvironmentSecretReference, map[string]bool, error) {
+    references, err := c.GetEnvironmentSecretReferences(ctx, projectID)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    migrations, err := c.GetLatestWorkflowMigrations(ctx, projectID)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    deployed := make(map[string]bool)
+    for _, migration := range migrations {
+        if migration.Deployment.IsActive {
+            deployed[migration.WorkflowID] = true
+        }
+    }
+
+    for key := range references {
+        sort.SliceStable(references[key], func(i, j int) bool {
+            return references[key][i].WorkflowDescription < references[key][j].WorkflowDescription
+        })
+    }
+
+    return references, deployed, nil
+}
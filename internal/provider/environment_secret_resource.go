@@ -3,6 +3,7 @@ package provider
 
 import (
     "context"
+    "fmt"
     "strings"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 var (
     _ resource.Resource              = &environmentSecretResource{}
     _ resource.ResourceWithConfigure = &environmentSecretResource{}
+    _ resource.ResourceWithModifyPlan = &environmentSecretResource{}
 )
 
 // NewEnvironmentSecretResource is a helper function to simplify the provider implementation.
@@ -32,11 +34,13 @@ type environmentSecretResource struct {
 
 // environmentSecretResourceModel maps the resource schema data.
 type environmentSecretResourceModel struct {
-    ID        types.String `tfsdk:"id"`
-    ProjectID types.String `tfsdk:"project_id"`
-    Key       types.String `tfsdk:"key"`
-    Value     types.String `tfsdk:"value"`
-    Hash      types.String `tfsdk:"hash"`
+    ID          types.String `tfsdk:"id"`
+    ProjectID   types.String `tfsdk:"project_id"`
+    Key         types.String `tfsdk:"key"`
+    Value       types.String `tfsdk:"value"`
+    Hash        types.String `tfsdk:"hash"`
+    WrittenHash types.String `tfsdk:"written_hash"`
+    OnDestroy   types.String `tfsdk:"on_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -91,10 +95,71 @@ func (r *environmentSecretResource) Schema(_ context.Context, _ resource.SchemaR
                 Description: "Hash of the environment secret.",
                 Computed:    true,
             },
+            "written_hash": schema.StringAttribute{
+                Description: "Hash of the environment secret when it was last written by terraform, used to detect changes made outside of terraform.",
+                Computed:    true,
+            },
+            "on_destroy": onDestroyAttribute("environment secret", "deletes the secret", ""),
         },
     }
 }
 
+// ModifyPlan warns when a secret that is removed (destroyed, or replaced because its key changed)
+// is still referenced by a deployed workflow.
+func (r *environmentSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing is removed on create
+    if req.State.Raw.IsNull() {
+        return
+    }
+
+    var state environmentSecretResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // An abandoned secret is kept in Paragon
+    if req.Plan.Raw.IsNull() && state.OnDestroy.ValueString() == onDestroyAbandon {
+        return
+    }
+
+    if !req.Plan.Raw.IsNull() {
+        var plan environmentSecretResourceModel
+        resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+        if resp.Diagnostics.HasError() {
+            return
+        }
+        if plan.Key.Equal(state.Key) && plan.ProjectID.Equal(state.ProjectID) {
+            return
+        }
+    }
+
+    references, deployed, err := environmentSecretReferences(ctx, r.client, state.ProjectID.ValueString())
+    if err != nil {
+        // The check is best effort and must not block the plan
+        resp.Diagnostics.AddWarning(
+            "Could not check environment secret references",
+            "Could not check whether deployed workflows reference the environment secret "+state.Key.ValueString()+": "+err.Error(),
+        )
+        return
+    }
+
+    var deployedReferences []string
+    for _, reference := range references[state.Key.ValueString()] {
+        if deployed[reference.WorkflowID] {
+            deployedReferences = append(deployedReferences, fmt.Sprintf("- workflow %q (%s), step %s", reference.WorkflowDescription, reference.WorkflowID, reference.StepID))
+        }
+    }
+
+    if len(deployedReferences) > 0 {
+        resp.Diagnostics.AddWarning(
+            "Environment secret is referenced by deployed workflows",
+            fmt.Sprintf("The environment secret %s is removed by this plan, but it is still referenced by deployed workflows:\n%s",
+                state.Key.ValueString(), strings.Join(deployedReferences, "\n")),
+        )
+    }
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *environmentSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan environmentSecretResourceModel
@@ -121,6 +186,7 @@ func (r *environmentSecretResource) Create(ctx context.Context, req resource.Cre
     // Map response body to schema and populate Computed attribute values
     plan.ID = types.StringValue(secret.ID)
     plan.Hash = types.StringValue(secret.Hash)
+    plan.WrittenHash = types.StringValue(secret.Hash)
 
     // Set state to fully populated data
     diags = resp.State.Set(ctx, plan)
@@ -139,6 +205,8 @@ func (r *environmentSecretResource) Read(ctx context.Context, req resource.ReadR
         return
     }
 
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
     projectID := state.ProjectID.ValueString()
 
     // Retrieve the environment secrets using the GetEnvironmentSecrets function
@@ -175,6 +243,21 @@ func (r *environmentSecretResource) Read(ctx context.Context, req resource.ReadR
     state.Key = types.StringValue(secret.Key)
     state.Hash = types.StringValue(secret.Hash)
 
+    // Secrets written before the hash was recorded start from the current one
+    if state.WrittenHash.IsNull() {
+        state.WrittenHash = types.StringValue(secret.Hash)
+    }
+
+    // Values are never read back, a different hash means the value was changed outside terraform.
+    // Clearing the value makes the next plan restore the configured one.
+    if secret.Hash != state.WrittenHash.ValueString() {
+        resp.Diagnostics.AddWarning(
+            "Environment secret changed outside of Terraform",
+            fmt.Sprintf("The value of the environment secret %s was changed outside of Terraform (its hash is no longer the one Terraform wrote). The configured value will be restored on the next apply.", secret.Key),
+        )
+        state.Value = types.StringNull()
+    }
+
     // Set the refreshed state
     diags = resp.State.Set(ctx, &state)
     resp.Diagnostics.Append(diags...)
@@ -204,6 +287,17 @@ func (r *environmentSecretResource) Update(ctx context.Context, req resource.Upd
     key := plan.Key.ValueString()
     value := plan.Value.ValueString()
 
+    // Only on_destroy changed, writing the same secret again would only rotate its hash
+    if plan.Key.Equal(state.Key) && plan.Value.Equal(state.Value) {
+        plan.ID = state.ID
+        plan.Hash = state.Hash
+        plan.WrittenHash = state.WrittenHash
+
+        diags = resp.State.Set(ctx, plan)
+        resp.Diagnostics.Append(diags...)
+        return
+    }
+
     // Update the environment secret using the UpdateEnvironmentSecret function
     updatedSecret, err := r.client.UpdateEnvironmentSecret(ctx, projectID, secretID, key, value)
     if err != nil {
@@ -224,6 +318,7 @@ func (r *environmentSecretResource) Update(ctx context.Context, req resource.Upd
     // Update the state with the updated data
     plan.Hash = types.StringValue(updatedSecret.Hash)
     plan.ID = types.StringValue(updatedSecret.ID)
+    plan.WrittenHash = types.StringValue(updatedSecret.Hash)
 
     // Set the updated state
     diags = resp.State.Set(ctx, plan)
@@ -242,6 +337,10 @@ func (r *environmentSecretResource) Delete(ctx context.Context, req resource.Del
         return
     }
 
+    if abandonOnDestroy(state.OnDestroy, "environment secret", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ProjectID.ValueString()
     secretID := state.ID.ValueString()
 
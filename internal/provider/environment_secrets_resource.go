@@ -0,0 +1,433 @@
+package provider
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource              = &environmentSecretsResource{}
+    _ resource.ResourceWithConfigure = &environmentSecretsResource{}
+)
+
+// NewEnvironmentSecretsResource is a helper function to simplify the provider implementation.
+func NewEnvironmentSecretsResource() resource.Resource {
+    return &environmentSecretsResource{}
+}
+
+// environmentSecretsResource is the resource implementation.
+type environmentSecretsResource struct {
+    client *client.Client
+}
+
+// environmentSecretsResourceModel maps the resource schema data.
+type environmentSecretsResourceModel struct {
+    ID            types.String `tfsdk:"id"`
+    ProjectID     types.String `tfsdk:"project_id"`
+    Secrets       types.Map    `tfsdk:"secrets"`
+    Authoritative types.Bool   `tfsdk:"authoritative"`
+    Hashes        types.Map    `tfsdk:"hashes"`
+    SecretIDs     types.Map    `tfsdk:"secret_ids"`
+    OnDestroy     types.String `tfsdk:"on_destroy"`
+}
+
+// environmentSecretsState is the decoded secrets of the resource, keyed by secret key.
+type environmentSecretsState struct {
+    values map[string]string
+    hashes map[string]string
+    ids    map[string]string
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentSecretsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *environmentSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_environment_secrets"
+}
+
+// Schema defines the schema for the resource.
+func (r *environmentSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages the environment secrets of a project as a single map.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the resource (the project ID).",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "secrets": schema.MapAttribute{
+                Description: "The environment secrets, key to value.",
+                Required:    true,
+                Sensitive:   true,
+                ElementType: types.StringType,
+                Validators: []validator.Map{
+                    mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+                    mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+                },
+            },
+            "authoritative": schema.BoolAttribute{
+                Description: "Delete the environment secrets of the project that are not in `secrets`, and overwrite existing secrets with the same keys. Must not be combined with paragon_environment_secret resources in the same project. (Default = false)",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+            },
+            "hashes": schema.MapAttribute{
+                Description: "The hash of each secret, as recorded when it was last written.",
+                Computed:    true,
+                ElementType: types.StringType,
+            },
+            "secret_ids": schema.MapAttribute{
+                Description: "The identifier of each secret.",
+                Computed:    true,
+                ElementType: types.StringType,
+            },
+            "on_destroy": onDestroyAttribute("environment secrets", "deletes the managed secrets", ""),
+        },
+    }
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *environmentSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan environmentSecretsResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    current := environmentSecretsState{
+        values: map[string]string{},
+        hashes: map[string]string{},
+        ids:    map[string]string{},
+    }
+
+    plan.ID = plan.ProjectID
+    resp.Diagnostics.Append(r.converge(ctx, &plan, current)...)
+
+    // Set state even on failures, so the written secrets are tracked
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *environmentSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state environmentSecretsResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
+    current, diags := decodeEnvironmentSecrets(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // A single listing covers all the secrets
+    secrets, err := r.client.GetEnvironmentSecrets(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        if strings.Contains(err.Error(), "status code: 404") {
+            resp.State.RemoveResource(ctx)
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading environment secrets",
+            "Could not read environment secrets, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    serverSecrets := make(map[string]client.EnvironmentSecret)
+    for _, secret := range secrets {
+        serverSecrets[secret.Key] = secret
+    }
+
+    for key := range current.values {
+        secret, ok := serverSecrets[key]
+        if !ok {
+            // Deleted outside terraform, the next apply creates it again
+            delete(current.values, key)
+            delete(current.hashes, key)
+            delete(current.ids, key)
+            continue
+        }
+
+        current.ids[key] = secret.ID
+        if secret.Hash != current.hashes[key] {
+            // Changed outside terraform - values are never read back, so an empty value
+            // makes the next apply write the configured value again
+            current.values[key] = ""
+        }
+    }
+
+    if state.Authoritative.ValueBool() {
+        // Unmanaged secrets are tracked with an empty value, so the next apply deletes them
+        for key, secret := range serverSecrets {
+            if _, ok := current.values[key]; !ok {
+                current.values[key] = ""
+                current.hashes[key] = secret.Hash
+                current.ids[key] = secret.ID
+            }
+        }
+    }
+
+    resp.Diagnostics.Append(encodeEnvironmentSecrets(ctx, &state, current)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *environmentSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan environmentSecretsResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state environmentSecretsResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    current, diags := decodeEnvironmentSecrets(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    plan.ID = state.ID
+    resp.Diagnostics.Append(r.converge(ctx, &plan, current)...)
+
+    // Set state even on failures, so the written secrets are tracked
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *environmentSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state environmentSecretsResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "environment secrets", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
+    current, diags := decodeEnvironmentSecrets(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+    for _, key := range sortedKeys(current.ids) {
+        err := r.client.DeleteEnvironmentSecret(ctx, projectID, current.ids[key])
+        if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+            resp.Diagnostics.AddError(
+                "Error deleting environment secret",
+                fmt.Sprintf("Could not delete environment secret %s, unexpected error: %s", key, err.Error()),
+            )
+        }
+    }
+}
+
+// converge writes the planned secrets that differ from the current ones, deletes the ones no longer planned
+// and records the result in the plan. Secrets that fail keep their previous state, so the next apply retries them.
+func (r *environmentSecretsResource) converge(ctx context.Context, plan *environmentSecretsResourceModel, current environmentSecretsState) diag.Diagnostics {
+    var diags diag.Diagnostics
+    projectID := plan.ProjectID.ValueString()
+
+    var planned map[string]string
+    diags.Append(plan.Secrets.ElementsAs(ctx, &planned, false)...)
+    if diags.HasError() {
+        return diags
+    }
+
+    secrets, err := r.client.GetEnvironmentSecrets(ctx, projectID)
+    if err != nil {
+        diags.AddError(
+            "Error reading environment secrets",
+            "Could not read environment secrets, unexpected error: "+err.Error(),
+        )
+        return diags
+    }
+
+    serverSecrets := make(map[string]client.EnvironmentSecret)
+    for _, secret := range secrets {
+        serverSecrets[secret.Key] = secret
+    }
+
+    // Secrets created outside of this resource (e.g. by paragon_environment_secret) are only overwritten when authoritative
+    if !plan.Authoritative.ValueBool() {
+        var unmanaged []string
+        for _, key := range sortedKeys(planned) {
+            if _, exists := serverSecrets[key]; !exists {
+                continue
+            }
+            if _, managed := current.ids[key]; !managed {
+                unmanaged = append(unmanaged, key)
+            }
+        }
+        if len(unmanaged) > 0 {
+            diags.AddAttributeError(
+                path.Root("secrets"),
+                "Environment secrets already exist",
+                fmt.Sprintf("The project already has the environment secrets %s, not managed by this resource. "+
+                    "Remove them from secrets, delete them first, or set authoritative = true to overwrite them.", strings.Join(unmanaged, ", ")),
+            )
+            diags.Append(encodeEnvironmentSecrets(ctx, plan, current)...)
+            return diags
+        }
+    }
+
+    result := environmentSecretsState{
+        values: map[string]string{},
+        hashes: map[string]string{},
+        ids:    map[string]string{},
+    }
+    keep := func(key string) {
+        if _, ok := current.values[key]; ok {
+            result.values[key] = current.values[key]
+            result.hashes[key] = current.hashes[key]
+            result.ids[key] = current.ids[key]
+        }
+    }
+
+    for _, key := range sortedKeys(planned) {
+        value := planned[key]
+        serverSecret, exists := serverSecrets[key]
+
+        if currentValue, ok := current.values[key]; ok && currentValue == value && exists {
+            keep(key)
+            continue
+        }
+
+        var secret *client.EnvironmentSecret
+        if exists {
+            // Existing secrets are overwritten with the configured value
+            secret, err = r.client.UpdateEnvironmentSecret(ctx, projectID, serverSecret.ID, key, value)
+        } else {
+            secret, err = r.client.CreateEnvironmentSecret(ctx, projectID, key, value)
+        }
+        if err != nil {
+            diags.AddError(
+                "Error writing environment secret",
+                fmt.Sprintf("Could not write environment secret %s, unexpected error: %s", key, err.Error()),
+            )
+            keep(key)
+            continue
+        }
+
+        result.values[key] = value
+        result.hashes[key] = secret.Hash
+        result.ids[key] = secret.ID
+    }
+
+    toDelete := make(map[string]string)
+    for key, id := range current.ids {
+        if _, ok := planned[key]; !ok {
+            toDelete[key] = id
+        }
+    }
+    if plan.Authoritative.ValueBool() {
+        for key, secret := range serverSecrets {
+            if _, ok := planned[key]; !ok {
+                toDelete[key] = secret.ID
+            }
+        }
+    }
+
+    for _, key := range sortedKeys(toDelete) {
+        err := r.client.DeleteEnvironmentSecret(ctx, projectID, toDelete[key])
+        if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+            diags.AddError(
+                "Error deleting environment secret",
+                fmt.Sprintf("Could not delete environment secret %s, unexpected error: %s", key, err.Error()),
+            )
+            keep(key)
+        }
+    }
+
+    diags.Append(encodeEnvironmentSecrets(ctx, plan, result)...)
+    return diags
+}
+
+// decodeEnvironmentSecrets decodes the secrets, hashes and IDs of the model.
+func decodeEnvironmentSecrets(ctx context.Context, model *environmentSecretsResourceModel) (environmentSecretsState, diag.Diagnostics) {
+    var diags diag.Diagnostics
+    decoded := environmentSecretsState{
+        values: map[string]string{},
+        hashes: map[string]string{},
+        ids:    map[string]string{},
+    }
+
+    if !model.Secrets.IsNull() && !model.Secrets.IsUnknown() {
+        diags.Append(model.Secrets.ElementsAs(ctx, &decoded.values, false)...)
+    }
+    if !model.Hashes.IsNull() && !model.Hashes.IsUnknown() {
+        diags.Append(model.Hashes.ElementsAs(ctx, &decoded.hashes, false)...)
+    }
+    if !model.SecretIDs.IsNull() && !model.SecretIDs.IsUnknown() {
+        diags.Append(model.SecretIDs.ElementsAs(ctx, &decoded.ids, false)...)
+    }
+
+    return decoded, diags
+}
+
+// encodeEnvironmentSecrets sets the secrets, hashes and IDs of the model.
+func encodeEnvironmentSecrets(ctx context.Context, model *environmentSecretsResourceModel, secrets environmentSecretsState) diag.Diagnostics {
+    var diags, d diag.Diagnostics
+
+    model.Secrets, d = types.MapValueFrom(ctx, types.StringType, secrets.values)
+    diags.Append(d...)
+    model.Hashes, d = types.MapValueFrom(ctx, types.StringType, secrets.hashes)
+    diags.Append(d...)
+    model.SecretIDs, d = types.MapValueFrom(ctx, types.StringType, secrets.ids)
+    diags.Append(d...)
+
+    return diags
+}
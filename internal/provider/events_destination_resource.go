@@ -40,6 +40,7 @@ type eventsDestinationResourceModel struct {
     Events    types.List     `tfsdk:"events"`
     Email     *emailBlock    `tfsdk:"email"`
     Webhook   *webhookBlock  `tfsdk:"webhook"`
+    OnDestroy types.String   `tfsdk:"on_destroy"`
 }
 
 type emailBlock struct {
@@ -121,6 +122,7 @@ func (r *eventsDestinationResource) Schema(_ context.Context, _ resource.SchemaR
                     },
                 },
             },
+            "on_destroy": onDestroyAttribute("events destination", "deletes the destination", ""),
         },
     }
 }
@@ -236,6 +238,8 @@ func (r *eventsDestinationResource) Read(ctx context.Context, req resource.ReadR
        return
    }
 
+   defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
    // Retrieve the events destination using the API
    eventDestination, err := r.client.GetEventDestination(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
    if err != nil {
@@ -397,6 +401,10 @@ func (r *eventsDestinationResource) Delete(ctx context.Context, req resource.Del
        return
    }
 
+   if abandonOnDestroy(state.OnDestroy, "events destination", state.ID.ValueString(), &resp.Diagnostics) {
+       return
+   }
+
    // Delete the events destination
    err := r.client.DeleteEventDestination(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
    if err != nil {
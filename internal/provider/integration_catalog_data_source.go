@@ -0,0 +1,140 @@
+package provider
+
+import (
+    "context"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &integrationCatalogDataSource{}
+    _ datasource.DataSourceWithConfigure = &integrationCatalogDataSource{}
+)
+
+// NewIntegrationCatalogDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationCatalogDataSource() datasource.DataSource {
+    return &integrationCatalogDataSource{}
+}
+
+// integrationCatalogDataSource is the data source implementation.
+type integrationCatalogDataSource struct {
+    client *client.Client
+}
+
+// integrationCatalogDataSourceModel maps the data source schema data.
+type integrationCatalogDataSourceModel struct {
+    ProjectID    types.String                            `tfsdk:"project_id"`
+    Integrations map[string]integrationCatalogEntryModel `tfsdk:"integrations"`
+}
+
+type integrationCatalogEntryModel struct {
+    Name                      types.String   `tfsdk:"name"`
+    AuthenticationType        types.String   `tfsdk:"authentication_type"`
+    DefaultScopes             []types.String `tfsdk:"default_scopes"`
+    AvailableScopes           []types.String `tfsdk:"available_scopes"`
+    RequiredConfigurationKeys []types.String `tfsdk:"required_configuration_keys"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *integrationCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *integrationCatalogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration_catalog"
+}
+
+// Schema defines the schema for the data source.
+func (d *integrationCatalogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the integration types Paragon supports, with their OAuth scopes and required configuration.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "integrations": schema.MapNestedAttribute{
+                Description: "The supported integrations keyed by their type.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "name": schema.StringAttribute{
+                            Description: "The display name of the integration.",
+                            Computed:    true,
+                        },
+                        "authentication_type": schema.StringAttribute{
+                            Description: "The authentication scheme of the integration (e.g. oauth).",
+                            Computed:    true,
+                        },
+                        "default_scopes": schema.ListAttribute{
+                            Description: "The OAuth scopes requested by default.",
+                            Computed:    true,
+                            ElementType: types.StringType,
+                        },
+                        "available_scopes": schema.ListAttribute{
+                            Description: "Every OAuth scope the integration supports.",
+                            Computed:    true,
+                            ElementType: types.StringType,
+                        },
+                        "required_configuration_keys": schema.ListAttribute{
+                            Description: "The extra configuration keys the credentials of the integration must set.",
+                            Computed:    true,
+                            ElementType: types.StringType,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *integrationCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state integrationCatalogDataSourceModel
+
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    catalog, err := d.client.GetIntegrationCatalog(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Integration Catalog",
+            err.Error(),
+        )
+        return
+    }
+
+    state.Integrations = make(map[string]integrationCatalogEntryModel)
+    for _, entry := range catalog {
+        state.Integrations[entry.Type] = integrationCatalogEntryModel{
+            Name:                      types.StringValue(entry.Name),
+            AuthenticationType:        types.StringValue(entry.AuthenticationType),
+            DefaultScopes:             client.ConvertStringSliceToTypesStringSlice(entry.DefaultScopes),
+            AvailableScopes:           client.ConvertStringSliceToTypesStringSlice(entry.AvailableScopes),
+            RequiredConfigurationKeys: client.ConvertStringSliceToTypesStringSlice(entry.RequiredConfigurationKeys),
+        }
+    }
+
+    // Set state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
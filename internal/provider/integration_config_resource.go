@@ -0,0 +1,577 @@
+package provider
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "regexp"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource              = &integrationConfigResource{}
+    _ resource.ResourceWithConfigure = &integrationConfigResource{}
+)
+
+// NewIntegrationConfigResource is a helper function to simplify the provider implementation.
+func NewIntegrationConfigResource() resource.Resource {
+    return &integrationConfigResource{}
+}
+
+// integrationConfigResource is the resource implementation.
+type integrationConfigResource struct {
+    client *client.Client
+}
+
+// integrationConfigResourceModel maps the resource schema data.
+type integrationConfigResourceModel struct {
+    ID            types.String                              `tfsdk:"id"`
+    ProjectID     types.String                              `tfsdk:"project_id"`
+    IntegrationID types.String                              `tfsdk:"integration_id"`
+    Description   types.String                              `tfsdk:"description"`
+    Overview      types.String                              `tfsdk:"overview"`
+    AccentColor   types.String                              `tfsdk:"accent_color"`
+    UserSettings  []integrationConfigInputModel             `tfsdk:"user_settings"`
+    Workflows     map[string]integrationConfigWorkflowModel `tfsdk:"workflows"`
+    OnDestroy     types.String                              `tfsdk:"on_destroy"`
+}
+
+type integrationConfigInputModel struct {
+    ID       types.String `tfsdk:"id"`
+    Title    types.String `tfsdk:"title"`
+    Type     types.String `tfsdk:"type"`
+    Required types.Bool   `tfsdk:"required"`
+    Tooltip  types.String `tfsdk:"tooltip"`
+}
+
+type integrationConfigWorkflowModel struct {
+    Hidden         types.Bool                    `tfsdk:"hidden"`
+    DefaultEnabled types.Bool                    `tfsdk:"default_enabled"`
+    Inputs         []integrationConfigInputModel `tfsdk:"inputs"`
+}
+
+// integrationConfigWorkflowMeta is the Connect Portal configuration of a workflow, under the workflowMeta value.
+type integrationConfigWorkflowMeta struct {
+    Hidden         bool                            `json:"hidden"`
+    DefaultEnabled bool                            `json:"defaultEnabled"`
+    Inputs         []client.IntegrationConfigInput `json:"inputs"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *integrationConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration_config"
+}
+
+// integrationConfigInputAttributes returns the attributes of a Connect Portal setting input.
+func integrationConfigInputAttributes() map[string]schema.Attribute {
+    return map[string]schema.Attribute{
+        "id": schema.StringAttribute{
+            Description: "Identifier of the input, used to read the value the user entered.",
+            Required:    true,
+        },
+        "title": schema.StringAttribute{
+            Description: "Title of the input shown to the user.",
+            Required:    true,
+        },
+        "type": schema.StringAttribute{
+            Description: "Type of the input (e.g. TEXT, NUMBER, BOOLEAN).",
+            Required:    true,
+            Validators: []validator.String{
+                stringvalidator.LengthAtLeast(1),
+            },
+        },
+        "required": schema.BoolAttribute{
+            Description: "Indicates if the user must fill the input. (Default = false)",
+            Optional:    true,
+            Computed:    true,
+            Default:     booldefault.StaticBool(false),
+        },
+        "tooltip": schema.StringAttribute{
+            Description: "Tooltip shown next to the input.",
+            Optional:    true,
+            Validators: []validator.String{
+                stringvalidator.LengthAtLeast(1),
+            },
+        },
+    }
+}
+
+// Schema defines the schema for the resource.
+func (r *integrationConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Manages the Connect Portal configuration of an integration. Only the configured settings are managed, the others are left untouched.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the integration configuration.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "integration_id": schema.StringAttribute{
+                Description: "Identifier of the integration.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "description": schema.StringAttribute{
+                Description: "Short description of the integration in the Connect Portal.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "overview": schema.StringAttribute{
+                Description: "Overview text (markdown) of the integration in the Connect Portal.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "accent_color": schema.StringAttribute{
+                Description: "Accent color of the Connect Portal, as a hex color (e.g. #4A154B).",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.RegexMatches(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "Must be a hex color, e.g. #4A154B"),
+                },
+            },
+            "user_settings": schema.ListNestedAttribute{
+                Description: "Settings the connected users fill when connecting the integration, shared by all its workflows.",
+                Optional:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: integrationConfigInputAttributes(),
+                },
+            },
+            "workflows": schema.MapNestedAttribute{
+                Description: "Connect Portal configuration of workflows, keyed by workflow ID. Workflows that are not listed are left untouched.",
+                Optional:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "hidden": schema.BoolAttribute{
+                            Description: "Hides the workflow from the Connect Portal. (Default = false)",
+                            Optional:    true,
+                            Computed:    true,
+                            Default:     booldefault.StaticBool(false),
+                        },
+                        "default_enabled": schema.BoolAttribute{
+                            Description: "Enables the workflow by default when a user connects the integration. (Default = false)",
+                            Optional:    true,
+                            Computed:    true,
+                            Default:     booldefault.StaticBool(false),
+                        },
+                        "inputs": schema.ListNestedAttribute{
+                            Description: "Settings the connected users fill when enabling the workflow.",
+                            Optional:    true,
+                            NestedObject: schema.NestedAttributeObject{
+                                Attributes: integrationConfigInputAttributes(),
+                            },
+                        },
+                    },
+                },
+            },
+            "on_destroy": onDestroyAttribute("integration configuration", "removes the managed settings", ""),
+        },
+    }
+}
+
+// integrationConfigInputs converts setting inputs to their API format.
+func integrationConfigInputs(inputs []integrationConfigInputModel) []client.IntegrationConfigInput {
+    result := make([]client.IntegrationConfigInput, 0, len(inputs))
+    for _, input := range inputs {
+        result = append(result, client.IntegrationConfigInput{
+            ID:       input.ID.ValueString(),
+            Title:    input.Title.ValueString(),
+            Type:     input.Type.ValueString(),
+            Required: input.Required.ValueBool(),
+            Tooltip:  input.Tooltip.ValueString(),
+        })
+    }
+    return result
+}
+
+// integrationConfigInputModels converts setting inputs from their API format, nil when there are none.
+func integrationConfigInputModels(inputs []client.IntegrationConfigInput) []integrationConfigInputModel {
+    if inputs == nil {
+        return nil
+    }
+
+    result := make([]integrationConfigInputModel, 0, len(inputs))
+    for _, input := range inputs {
+        tooltip := types.StringNull()
+        if input.Tooltip != "" {
+            tooltip = types.StringValue(input.Tooltip)
+        }
+        result = append(result, integrationConfigInputModel{
+            ID:       types.StringValue(input.ID),
+            Title:    types.StringValue(input.Title),
+            Type:     types.StringValue(input.Type),
+            Required: types.BoolValue(input.Required),
+            Tooltip:  tooltip,
+        })
+    }
+    return result
+}
+
+// decodeIntegrationConfigValue decodes a free-form configuration value into out.
+func decodeIntegrationConfigValue(value any, out any) error {
+    jsonValue, err := json.Marshal(value)
+    if err != nil {
+        return err
+    }
+    return json.Unmarshal(jsonValue, out)
+}
+
+// integrationConfigObject returns a configuration value as an object, empty when it is missing.
+func integrationConfigObject(values map[string]any, key string) map[string]any {
+    object := make(map[string]any)
+    if value, ok := values[key]; ok && value != nil {
+        _ = decodeIntegrationConfigValue(value, &object)
+    }
+    return object
+}
+
+// setIntegrationConfigString writes a managed string value, removing it when it is no longer managed.
+func setIntegrationConfigString(values map[string]any, key string, value, prior types.String) {
+    if !value.IsNull() {
+        values[key] = value.ValueString()
+    } else if !prior.IsNull() {
+        delete(values, key)
+    }
+}
+
+// integrationConfig returns the configuration configID of the integration, or its first configuration when configID is
+// empty (nil if it has none). A configID that no longer exists is reported as not found, so another configuration is never used.
+func (r *integrationConfigResource) integrationConfig(ctx context.Context, projectID, integrationID, configID string) (*client.IntegrationConfig, error) {
+    integration, err := r.client.GetIntegration(ctx, projectID, integrationID)
+    if err != nil {
+        return nil, err
+    }
+
+    if configID == "" {
+        if len(integration.Configs) == 0 {
+            return nil, nil
+        }
+        return &integration.Configs[0], nil
+    }
+
+    for i := range integration.Configs {
+        if integration.Configs[i].ID == configID {
+            return &integration.Configs[i], nil
+        }
+    }
+    return nil, fmt.Errorf("integration configuration %s not found, status code: 404", configID)
+}
+
+// apply merges the managed settings of plan into the integration configuration, removing the ones only in prior.
+func (r *integrationConfigResource) apply(ctx context.Context, config *client.IntegrationConfig, plan, prior *integrationConfigResourceModel) diag.Diagnostics {
+    var diags diag.Diagnostics
+    projectID := plan.ProjectID.ValueString()
+    integrationID := plan.IntegrationID.ValueString()
+
+    values := make(map[string]any)
+    for key, value := range config.Values {
+        values[key] = value
+    }
+
+    setIntegrationConfigString(values, client.IntegrationConfigDescription, plan.Description, prior.Description)
+    setIntegrationConfigString(values, client.IntegrationConfigOverview, plan.Overview, prior.Overview)
+    setIntegrationConfigString(values, client.IntegrationConfigAccentColor, plan.AccentColor, prior.AccentColor)
+
+    if plan.UserSettings != nil || prior.UserSettings != nil {
+        sharedMeta := integrationConfigObject(values, client.IntegrationConfigSharedMeta)
+        if plan.UserSettings != nil {
+            sharedMeta["inputs"] = integrationConfigInputs(plan.UserSettings)
+        } else {
+            delete(sharedMeta, "inputs")
+        }
+        values[client.IntegrationConfigSharedMeta] = sharedMeta
+    }
+
+    if plan.Workflows != nil || prior.Workflows != nil {
+        workflowMeta := integrationConfigObject(values, client.IntegrationConfigWorkflowMeta)
+        for workflowID := range prior.Workflows {
+            if _, ok := plan.Workflows[workflowID]; !ok {
+                delete(workflowMeta, workflowID)
+            }
+        }
+        for workflowID, workflow := range plan.Workflows {
+            // Keep the settings of the workflow that are not managed here
+            meta := integrationConfigObject(workflowMeta, workflowID)
+            meta["hidden"] = workflow.Hidden.ValueBool()
+            meta["defaultEnabled"] = workflow.DefaultEnabled.ValueBool()
+            if workflow.Inputs != nil {
+                meta["inputs"] = integrationConfigInputs(workflow.Inputs)
+            } else {
+                delete(meta, "inputs")
+            }
+            workflowMeta[workflowID] = meta
+        }
+        values[client.IntegrationConfigWorkflowMeta] = workflowMeta
+    }
+
+    updatedConfig, err := r.client.UpdateIntegrationConfig(ctx, projectID, integrationID, config.ID, values)
+    if err != nil {
+        diags.AddError(
+            "Error updating integration configuration",
+            "Could not update integration configuration, unexpected error: "+err.Error(),
+        )
+        return diags
+    }
+
+    plan.ID = types.StringValue(updatedConfig.ID)
+    return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *integrationConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan integrationConfigResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    config, err := r.integrationConfig(ctx, plan.ProjectID.ValueString(), plan.IntegrationID.ValueString(), "")
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integration configuration",
+            "Could not read integration configuration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+    if config == nil {
+        resp.Diagnostics.AddError(
+            "Integration configuration not found",
+            "The integration "+plan.IntegrationID.ValueString()+" has no Connect Portal configuration.",
+        )
+        return
+    }
+
+    prior := integrationConfigResourceModel{
+        ID:          types.StringNull(),
+        Description: types.StringNull(),
+        Overview:    types.StringNull(),
+        AccentColor: types.StringNull(),
+    }
+    resp.Diagnostics.Append(r.apply(ctx, config, &plan, &prior)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *integrationConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state integrationConfigResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    config, err := r.integrationConfig(ctx, state.ProjectID.ValueString(), state.IntegrationID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        if strings.Contains(err.Error(), "status code: 404") {
+            resp.State.RemoveResource(ctx)
+        } else {
+            resp.Diagnostics.AddError(
+                "Error reading integration configuration",
+                "Could not read integration configuration, unexpected error: "+err.Error(),
+            )
+        }
+        return
+    }
+    if config == nil {
+        resp.State.RemoveResource(ctx)
+        return
+    }
+
+    // Only the managed settings are refreshed
+    readString := func(key string) types.String {
+        if value, ok := config.Values[key].(string); ok && value != "" {
+            return types.StringValue(value)
+        }
+        return types.StringNull()
+    }
+    if !state.Description.IsNull() {
+        state.Description = readString(client.IntegrationConfigDescription)
+    }
+    if !state.Overview.IsNull() {
+        state.Overview = readString(client.IntegrationConfigOverview)
+    }
+    if !state.AccentColor.IsNull() {
+        state.AccentColor = readString(client.IntegrationConfigAccentColor)
+    }
+
+    if state.UserSettings != nil {
+        var sharedMeta struct {
+            Inputs []client.IntegrationConfigInput `json:"inputs"`
+        }
+        if value, ok := config.Values[client.IntegrationConfigSharedMeta]; ok && value != nil {
+            err = decodeIntegrationConfigValue(value, &sharedMeta)
+            if err != nil {
+                resp.Diagnostics.AddError(
+                    "Error reading integration configuration",
+                    "Could not decode the user settings, unexpected error: "+err.Error(),
+                )
+                return
+            }
+        }
+        state.UserSettings = integrationConfigInputModels(sharedMeta.Inputs)
+    }
+
+    if state.Workflows != nil {
+        workflowMeta := integrationConfigObject(config.Values, client.IntegrationConfigWorkflowMeta)
+        workflows := make(map[string]integrationConfigWorkflowModel)
+        for workflowID := range state.Workflows {
+            value, ok := workflowMeta[workflowID]
+            if !ok || value == nil {
+                continue
+            }
+
+            var meta integrationConfigWorkflowMeta
+            err = decodeIntegrationConfigValue(value, &meta)
+            if err != nil {
+                resp.Diagnostics.AddError(
+                    "Error reading integration configuration",
+                    "Could not decode the configuration of workflow "+workflowID+", unexpected error: "+err.Error(),
+                )
+                return
+            }
+            workflows[workflowID] = integrationConfigWorkflowModel{
+                Hidden:         types.BoolValue(meta.Hidden),
+                DefaultEnabled: types.BoolValue(meta.DefaultEnabled),
+                Inputs:         integrationConfigInputModels(meta.Inputs),
+            }
+        }
+        state.Workflows = workflows
+    }
+
+    state.ID = types.StringValue(config.ID)
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *integrationConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan integrationConfigResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state integrationConfigResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    config, err := r.integrationConfig(ctx, plan.ProjectID.ValueString(), plan.IntegrationID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integration configuration",
+            "Could not read integration configuration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+    if config == nil {
+        resp.Diagnostics.AddError(
+            "Integration configuration not found",
+            "The integration "+plan.IntegrationID.ValueString()+" has no Connect Portal configuration.",
+        )
+        return
+    }
+
+    resp.Diagnostics.Append(r.apply(ctx, config, &plan, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *integrationConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state integrationConfigResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "integration configuration", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
+    config, err := r.integrationConfig(ctx, state.ProjectID.ValueString(), state.IntegrationID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        // The configuration was removed along with the integration
+        if strings.Contains(err.Error(), "status code: 404") {
+            return
+        }
+        resp.Diagnostics.AddError(
+            "Error reading integration configuration",
+            "Could not read integration configuration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+    if config == nil {
+        return
+    }
+
+    // Remove every managed setting, the settings managed elsewhere are kept
+    empty := integrationConfigResourceModel{
+        ProjectID:     state.ProjectID,
+        IntegrationID: state.IntegrationID,
+        Description:   types.StringNull(),
+        Overview:      types.StringNull(),
+        AccentColor:   types.StringNull(),
+    }
+    resp.Diagnostics.Append(r.apply(ctx, config, &empty, &state)...)
+}
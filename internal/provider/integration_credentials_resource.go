@@ -19,13 +19,14 @@ import (
     "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
     "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/diag"
-
+    "github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
     _ resource.Resource              = &integrationCredentialsResource{}
     _ resource.ResourceWithConfigure = &integrationCredentialsResource{}
+    _ resource.ResourceWithModifyPlan = &integrationCredentialsResource{}
 )
 
 // NewIntegrationCredentialsResource is a helper function to simplify the provider implementation.
@@ -47,6 +48,8 @@ type integrationCredentialsResourceModel struct {
     Provider           types.String `tfsdk:"creds_provider"`
     OAuth              *oauthModel  `tfsdk:"oauth"`
     ExtraConfiguration types.Map    `tfsdk:"extra_configuration"`
+    DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+    OnDestroy          types.String `tfsdk:"on_destroy"`
 }
 
 type oauthModel struct {
@@ -133,12 +136,14 @@ func (r *integrationCredentialsResource) Schema(_ context.Context, _ resource.Sc
                     },
                 },
             },
+            "deletion_protection": deletionProtectionAttribute("integration credentials"),
             "extra_configuration": schema.MapAttribute{
                 Description: "Additional configuration parameters for the integration credentials.",
                 ElementType: types.StringType,
                 Optional:    true,
                 Sensitive:   true,
             },
+            "on_destroy": onDestroyAttribute("integration credentials", "deletes the credentials", ""),
         },
     }
 }
@@ -200,6 +205,106 @@ func (r *integrationCredentialsResource) validateExtraConfiguration(ctx context.
     return diags
 }
 
+// validateAgainstCatalog checks the scopes and the extra configuration keys against the integration catalog.
+// Custom integrations are not in the catalog, and an unreadable catalog only warns.
+func (r *integrationCredentialsResource) validateAgainstCatalog(ctx context.Context, projectID string, scopes types.List, extraConfig types.Map, integration *client.Integration) diag.Diagnostics {
+    var diags diag.Diagnostics
+
+    if integration.Type == "custom" {
+        return diags
+    }
+
+    catalog, err := r.client.GetIntegrationCatalog(ctx, projectID)
+    if err != nil {
+        diags.AddWarning(
+            "Could not check the integration catalog",
+            "Could not validate the scopes and extra configuration of the "+integration.Type+" credentials: "+err.Error(),
+        )
+        return diags
+    }
+
+    var entry *client.IntegrationCatalogEntry
+    for i := range catalog {
+        if catalog[i].Type == integration.Type {
+            entry = &catalog[i]
+            break
+        }
+    }
+    if entry == nil {
+        return diags
+    }
+
+    if !scopes.IsNull() && !scopes.IsUnknown() && len(entry.AvailableScopes) > 0 {
+        available := make(map[string]bool)
+        for _, scope := range entry.AvailableScopes {
+            available[scope] = true
+        }
+        for _, element := range scopes.Elements() {
+            scope, ok := element.(types.String)
+            if !ok || scope.IsUnknown() || available[scope.ValueString()] {
+                continue
+            }
+            diags.AddAttributeError(
+                path.Root("oauth").AtName("scopes"),
+                "Unknown OAuth scope",
+                fmt.Sprintf("Scope '%s' is not supported by the %s integration. "+
+                    "The supported scopes are listed by the paragon_integration_catalog data source.", scope.ValueString(), integration.Type),
+            )
+        }
+    }
+
+    if !extraConfig.IsUnknown() {
+        elements := extraConfig.Elements()
+        var missing []string
+        for _, key := range entry.RequiredConfigurationKeys {
+            if _, ok := elements[key]; !ok {
+                missing = append(missing, key)
+            }
+        }
+        if len(missing) > 0 {
+            diags.AddAttributeError(
+                path.Root("extra_configuration"),
+                "Missing extra configuration",
+                fmt.Sprintf("The %s integration requires the extra configuration keys: %s", integration.Type, strings.Join(missing, ", ")),
+            )
+        }
+    }
+
+    return diags
+}
+
+// ModifyPlan validates the credentials against the integration catalog when the integration is known.
+func (r *integrationCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to validate on destroy
+    if req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var projectID, integrationID types.String
+    var scopes types.List
+    var extraConfig types.Map
+    resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+    resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("integration_id"), &integrationID)...)
+    resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("oauth").AtName("scopes"), &scopes)...)
+    resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extra_configuration"), &extraConfig)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // An integration created in the same apply is validated on create instead
+    if projectID.IsUnknown() || integrationID.IsUnknown() {
+        return
+    }
+
+    integration, err := r.client.GetIntegration(ctx, projectID.ValueString(), integrationID.ValueString())
+    if err != nil {
+        // Reported by create and read
+        return
+    }
+
+    resp.Diagnostics.Append(r.validateAgainstCatalog(ctx, projectID.ValueString(), scopes, extraConfig, integration)...)
+}
+
 // getExtraConfigurationKeys extracts the keys from the extra configuration for filtering during reads
 func (r *integrationCredentialsResource) getExtraConfigurationKeys(extraConfig types.Map) []string {
     var keys []string
@@ -375,6 +480,18 @@ func (r *integrationCredentialsResource) mergeCredentialValues(ctx context.Conte
     return values, nil
 }
 
+// credentialsFallbackName names the credentials when the access token has no email claim.
+const credentialsFallbackName = "terraform"
+
+// credentialsName returns the name of the credentials created by the provider, the email of the authenticated user if known.
+func credentialsName(c *client.Client) string {
+    email, err := c.GetUserEmailFromToken()
+    if err != nil || email == "" {
+        return credentialsFallbackName
+    }
+    return email
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *integrationCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan integrationCredentialsResourceModel
@@ -404,6 +521,12 @@ func (r *integrationCredentialsResource) Create(ctx context.Context, req resourc
         return
     }
 
+    // Validate against the catalog, skipped at plan time when the integration was not created yet
+    resp.Diagnostics.Append(r.validateAgainstCatalog(ctx, projectID, plan.OAuth.Scopes, plan.ExtraConfiguration, integration)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
     scopesStr := ""
 
     if integration.Type == "custom" {
@@ -441,15 +564,8 @@ func (r *integrationCredentialsResource) Create(ctx context.Context, req resourc
         scopesStr = strings.Join(scopes, " ")
     }
 
-    // Extract the user email from the access token
-    email, err := r.client.GetUserEmailFromToken()
-    if err != nil {
-        resp.Diagnostics.AddError(
-            "Error extracting user email from access token",
-            "Could not extract user email from access token, unexpected error: "+err.Error(),
-        )
-        return
-    }
+    // The credentials are named after the user, when the access token has an email claim
+    email := credentialsName(r.client)
 
     // Merge OAuth values with extra configuration
     values, err := r.mergeCredentialValues(ctx, plan.OAuth, plan.ExtraConfiguration, scopesStr)
@@ -501,6 +617,8 @@ func (r *integrationCredentialsResource) Read(ctx context.Context, req resource.
         return
     }
 
+    defaultDestroyAttributes(&state.DeletionProtection, &state.OnDestroy, onDestroyDelete)
+
     projectID := state.ProjectID.ValueString()
     credID := state.ID.ValueString()
 
@@ -681,15 +799,8 @@ func (r *integrationCredentialsResource) Update(ctx context.Context, req resourc
         return
     }
 
-    // Extract the user email from the access token
-    email, err := r.client.GetUserEmailFromToken()
-    if err != nil {
-        resp.Diagnostics.AddError(
-            "Error extracting user email from access token",
-            "Could not extract user email from access token, unexpected error: "+err.Error(),
-        )
-        return
-    }
+    // The credentials are named after the user, when the access token has an email claim
+    email := credentialsName(r.client)
 
     // Merge OAuth and extra configuration values for update request
     // This preserves type conversion consistency with Create operation
@@ -825,6 +936,15 @@ func (r *integrationCredentialsResource) Delete(ctx context.Context, req resourc
         return
     }
 
+    resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "integration credentials", state.ID.ValueString())...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "integration credentials", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ProjectID.ValueString()
     credentialID := state.ID.ValueString()
 
@@ -0,0 +1,295 @@
+package provider
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "strings"
+
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ datasource.DataSource              = &integrationDataSource{}
+    _ datasource.DataSourceWithConfigure = &integrationDataSource{}
+)
+
+// NewIntegrationDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationDataSource() datasource.DataSource {
+    return &integrationDataSource{}
+}
+
+// integrationDataSource is the data source implementation.
+type integrationDataSource struct {
+    client *client.Client
+}
+
+// integrationDataSourceModel maps the data source schema data.
+type integrationDataSourceModel struct {
+    ProjectID             types.String                       `tfsdk:"project_id"`
+    Type                  types.String                       `tfsdk:"type"`
+    CustomIntegrationSlug types.String                       `tfsdk:"custom_integration_slug"`
+    Name                  types.String                       `tfsdk:"name"`
+    ID                    types.String                       `tfsdk:"id"`
+    IsActive              types.Bool                         `tfsdk:"is_active"`
+    ConnectedUserCount    types.Int64                        `tfsdk:"connected_user_count"`
+    CustomIntegrationID   types.String                       `tfsdk:"custom_integration_id"`
+    CustomIntegration     *customIntegrationModel            `tfsdk:"custom_integration"`
+    Configs               []integrationDataSourceConfigModel `tfsdk:"configs"`
+}
+
+type customIntegrationModel struct {
+    ID                 types.String `tfsdk:"id"`
+    Name               types.String `tfsdk:"name"`
+    Slug               types.String `tfsdk:"slug"`
+    AuthenticationType types.String `tfsdk:"authentication_type"`
+}
+
+type integrationDataSourceConfigModel struct {
+    ID          types.String `tfsdk:"id"`
+    Values      types.String `tfsdk:"values"`
+    DateCreated types.String `tfsdk:"date_created"`
+    DateUpdated types.String `tfsdk:"date_updated"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *integrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *integrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *integrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches a single integration of a project by type, custom integration slug or name.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "type": schema.StringAttribute{
+                Description: "The type of the integration (e.g. slack). Exactly one of type, custom_integration_slug and name must be set.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.ExactlyOneOf(path.MatchRoot("custom_integration_slug"), path.MatchRoot("name")),
+                },
+            },
+            "custom_integration_slug": schema.StringAttribute{
+                Description: "The slug of the custom integration, with or without the custom. prefix.",
+                Optional:    true,
+            },
+            "name": schema.StringAttribute{
+                Description: "The name of the integration (case insensitive) - the custom integration name, or the catalog name of other integrations.",
+                Optional:    true,
+            },
+            "id": schema.StringAttribute{
+                Description: "The ID of the integration.",
+                Computed:    true,
+            },
+            "is_active": schema.BoolAttribute{
+                Description: "Indicates if the integration is active.",
+                Computed:    true,
+            },
+            "connected_user_count": schema.Int64Attribute{
+                Description: "The count of connected users for the integration.",
+                Computed:    true,
+            },
+            "custom_integration_id": schema.StringAttribute{
+                Description: "The custom integration ID in case of a custom integration.",
+                Computed:    true,
+            },
+            "custom_integration": schema.SingleNestedAttribute{
+                Description: "The details of the custom integration, null for other integrations.",
+                Computed:    true,
+                Attributes: map[string]schema.Attribute{
+                    "id": schema.StringAttribute{
+                        Description: "The ID of the custom integration.",
+                        Computed:    true,
+                    },
+                    "name": schema.StringAttribute{
+                        Description: "The name of the custom integration.",
+                        Computed:    true,
+                    },
+                    "slug": schema.StringAttribute{
+                        Description: "The slug of the custom integration.",
+                        Computed:    true,
+                    },
+                    "authentication_type": schema.StringAttribute{
+                        Description: "The authentication type of the custom integration.",
+                        Computed:    true,
+                    },
+                },
+            },
+            "configs": schema.ListNestedAttribute{
+                Description: "The configurations of the integration.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "The ID of the configuration.",
+                            Computed:    true,
+                        },
+                        "values": schema.StringAttribute{
+                            Description: "The values of the configuration, JSON encoded.",
+                            Computed:    true,
+                        },
+                        "date_created": schema.StringAttribute{
+                            Description: "The creation date of the configuration.",
+                            Computed:    true,
+                        },
+                        "date_updated": schema.StringAttribute{
+                            Description: "The last update date of the configuration.",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *integrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var state integrationDataSourceModel
+    diags := req.Config.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+
+    integrations, err := d.client.GetIntegrations(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Integrations",
+            err.Error(),
+        )
+        return
+    }
+
+    // Catalog integrations are named by the catalog
+    catalogNames := make(map[string]string)
+    if !state.Name.IsNull() {
+        catalog, err := d.client.GetIntegrationCatalog(ctx, projectID)
+        if err != nil {
+            resp.Diagnostics.AddWarning(
+                "Could not read the integration catalog",
+                "Only custom integrations are matched by name: "+err.Error(),
+            )
+        }
+        for _, entry := range catalog {
+            catalogNames[entry.Type] = entry.Name
+        }
+    }
+
+    lookup := "name '" + state.Name.ValueString() + "'"
+    if !state.Type.IsNull() {
+        lookup = "type '" + state.Type.ValueString() + "'"
+    } else if !state.CustomIntegrationSlug.IsNull() {
+        lookup = "custom integration slug '" + state.CustomIntegrationSlug.ValueString() + "'"
+    }
+
+    var matches []client.Integration
+    for _, integration := range integrations {
+        var match bool
+        switch {
+        case !state.Type.IsNull():
+            match = integration.Type == state.Type.ValueString() || integrationTypeName(&integration) == state.Type.ValueString()
+        case !state.CustomIntegrationSlug.IsNull():
+            slug := state.CustomIntegrationSlug.ValueString()
+            match = integration.CustomIntegration != nil &&
+                (integration.CustomIntegration.Slug == slug || integration.CustomIntegration.Slug == "custom."+slug)
+        default:
+            name := catalogNames[integration.Type]
+            if integration.CustomIntegration != nil {
+                name = integration.CustomIntegration.Name
+            }
+            match = name != "" && strings.EqualFold(name, state.Name.ValueString())
+        }
+        if match {
+            matches = append(matches, integration)
+        }
+    }
+
+    if len(matches) == 0 {
+        resp.Diagnostics.AddError(
+            "Integration Not Found",
+            fmt.Sprintf("No integration with %s found in project %s", lookup, projectID),
+        )
+        return
+    }
+    if len(matches) > 1 {
+        var ids []string
+        for _, integration := range matches {
+            ids = append(ids, fmt.Sprintf("%s (%s)", integration.ID, integrationTypeName(&integration)))
+        }
+        resp.Diagnostics.AddError(
+            "Multiple Integrations Found",
+            fmt.Sprintf("%d integrations with %s found in project %s, use a more specific lookup: %s",
+                len(matches), lookup, projectID, strings.Join(ids, ", ")),
+        )
+        return
+    }
+
+    integration := matches[0]
+    state.ID = types.StringValue(integration.ID)
+    state.IsActive = types.BoolValue(integration.IsActive)
+    state.ConnectedUserCount = types.Int64Value(int64(integration.ConnectedUserCount))
+    state.CustomIntegrationID = types.StringNull()
+    if integration.CustomIntegrationID != nil {
+        state.CustomIntegrationID = types.StringValue(*integration.CustomIntegrationID)
+    }
+    if integration.CustomIntegration != nil {
+        state.CustomIntegration = &customIntegrationModel{
+            ID:                 types.StringValue(integration.CustomIntegration.ID),
+            Name:               types.StringValue(integration.CustomIntegration.Name),
+            Slug:               types.StringValue(integration.CustomIntegration.Slug),
+            AuthenticationType: types.StringValue(integration.CustomIntegration.AuthenticationType),
+        }
+    }
+
+    state.Configs = []integrationDataSourceConfigModel{}
+    for _, config := range integration.Configs {
+        values, err := json.Marshal(config.Values)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Integration",
+                fmt.Sprintf("Could not encode the values of configuration %s: %v", config.ID, err),
+            )
+            return
+        }
+        state.Configs = append(state.Configs, integrationDataSourceConfigModel{
+            ID:          types.StringValue(config.ID),
+            Values:      types.StringValue(string(values)),
+            DateCreated: types.StringValue(config.DateCreated),
+            DateUpdated: types.StringValue(config.DateUpdated),
+        })
+    }
+
+    // Set state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
@@ -0,0 +1,539 @@
+package provider
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "sync"
+
+    "github.com/hashicorp/terraform-plugin-framework/attr"
+    "github.com/hashicorp/terraform-plugin-framework/diag"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// integrationWorkflowStatusFailed is recorded for workflows whose last deployment attempt failed, so they are retried.
+const integrationWorkflowStatusFailed = "FAILED"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource               = &integrationDeploymentResource{}
+    _ resource.ResourceWithConfigure  = &integrationDeploymentResource{}
+    _ resource.ResourceWithModifyPlan = &integrationDeploymentResource{}
+)
+
+// NewIntegrationDeploymentResource is a helper function to simplify the provider implementation.
+func NewIntegrationDeploymentResource() resource.Resource {
+    return &integrationDeploymentResource{}
+}
+
+// integrationDeploymentResource is the resource implementation.
+type integrationDeploymentResource struct {
+    client *client.Client
+}
+
+// integrationDeploymentResourceModel maps the resource schema data.
+type integrationDeploymentResourceModel struct {
+    ID             types.String `tfsdk:"id"`
+    ProjectID      types.String `tfsdk:"project_id"`
+    IntegrationID  types.String `tfsdk:"integration_id"`
+    Tags           types.Set    `tfsdk:"tags"`
+    MaxConcurrency types.Int64  `tfsdk:"max_concurrency"`
+    Workflows      types.Map    `tfsdk:"workflows"`
+    OnDestroy      types.String `tfsdk:"on_destroy"`
+}
+
+// integrationWorkflowDeploymentModel maps the deployment of a single workflow of the integration.
+type integrationWorkflowDeploymentModel struct {
+    Version      types.Int64  `tfsdk:"version"`
+    DeploymentID types.String `tfsdk:"deployment_id"`
+    Status       types.String `tfsdk:"status"`
+}
+
+var integrationWorkflowDeploymentType = types.ObjectType{
+    AttrTypes: map[string]attr.Type{
+        "version":       types.Int64Type,
+        "deployment_id": types.StringType,
+        "status":        types.StringType,
+    },
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationDeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *integrationDeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration_deployment"
+}
+
+// Schema defines the schema for the resource.
+func (r *integrationDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Deploys all the workflows (or a tag-filtered subset) of an integration.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the integration deployment (the integration ID).",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "integration_id": schema.StringAttribute{
+                Description: "The ID of the integration whose workflows are deployed.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "tags": schema.SetAttribute{
+                Description: "Only deploy the workflows having at least one of these tags. When omitted all the workflows of the integration are deployed.",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "max_concurrency": schema.Int64Attribute{
+                Description: "Maximum number of workflows deployed at the same time. (Default = 4)",
+                Optional:    true,
+                Computed:    true,
+                Default:     int64default.StaticInt64(4),
+                Validators: []validator.Int64{
+                    int64validator.AtLeast(1),
+                },
+            },
+            "workflows": schema.MapNestedAttribute{
+                Description: "The deployed workflows, keyed by workflow ID.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "version": schema.Int64Attribute{
+                            Description: "The workflow version that was deployed.",
+                            Computed:    true,
+                        },
+                        "deployment_id": schema.StringAttribute{
+                            Description: "The ID of the workflow deployment.",
+                            Computed:    true,
+                        },
+                        "status": schema.StringAttribute{
+                            Description: "The status of the workflow deployment (DEPLOYED, UNDEPLOYED or FAILED).",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+            "on_destroy": onDestroyAttribute("integration deployment", "undeploys the deployed workflows", ""),
+        },
+    }
+}
+
+// ModifyPlan plans a redeployment when the deployed workflows no longer match the integration workflows.
+func (r *integrationDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to compare on create or destroy
+    if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+        return
+    }
+
+    var plan, state integrationDeploymentResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if plan.ProjectID.IsUnknown() || plan.IntegrationID.IsUnknown() || plan.Tags.IsUnknown() {
+        return
+    }
+
+    tags, diags := integrationDeploymentTags(ctx, plan.Tags)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    targets, err := r.targetWorkflows(ctx, plan.ProjectID.ValueString(), plan.IntegrationID.ValueString(), tags)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading workflows",
+            "Could not read the workflows of the integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    current, diags := integrationWorkflowDeployments(ctx, state.Workflows)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    changed := len(current) != len(targets)
+    for workflowID, version := range targets {
+        if integrationWorkflowNeedsDeployment(version, current[workflowID]) {
+            changed = true
+            break
+        }
+    }
+
+    if changed {
+        plan.Workflows = types.MapUnknown(integrationWorkflowDeploymentType)
+    } else {
+        plan.Workflows = state.Workflows
+    }
+
+    resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *integrationDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan integrationDeploymentResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    plan.ID = plan.IntegrationID
+    resp.Diagnostics.Append(r.deploy(ctx, &plan, map[string]integrationWorkflowDeploymentModel{})...)
+
+    // Set state even on failures, so the deployed workflows are tracked
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *integrationDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state integrationDeploymentResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
+    current, diags := integrationWorkflowDeployments(ctx, state.Workflows)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    migrations, err := r.client.GetLatestWorkflowMigrations(ctx, state.ProjectID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integration deployment",
+            "Could not read integration deployment, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    activeDeployments := make(map[string]string)
+    for _, migration := range migrations {
+        if migration.Deployment.IsActive {
+            activeDeployments[migration.WorkflowID] = migration.Deployment.ID
+        }
+    }
+
+    // Workflows undeployed outside terraform are marked, so the next apply deploys them again
+    for workflowID, deployment := range current {
+        if deployment.Status.ValueString() != client.WorkflowDeploymentStatusDeployed {
+            continue
+        }
+        if deploymentID, ok := activeDeployments[workflowID]; ok {
+            deployment.DeploymentID = types.StringValue(deploymentID)
+        } else {
+            deployment.Status = types.StringValue(client.WorkflowDeploymentStatusUndeployed)
+        }
+        current[workflowID] = deployment
+    }
+
+    state.Workflows, diags = types.MapValueFrom(ctx, integrationWorkflowDeploymentType, current)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *integrationDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan integrationDeploymentResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state integrationDeploymentResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    current, diags := integrationWorkflowDeployments(ctx, state.Workflows)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    plan.ID = state.ID
+    resp.Diagnostics.Append(r.deploy(ctx, &plan, current)...)
+
+    // Set state even on failures, so the deployed workflows are tracked
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *integrationDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state integrationDeploymentResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "integration deployment", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
+    current, diags := integrationWorkflowDeployments(ctx, state.Workflows)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+    errs := runConcurrently(sortedKeys(current), state.MaxConcurrency.ValueInt64(), func(workflowID string) error {
+        return undeployWorkflowAndWait(ctx, r.client, projectID, workflowID, current[workflowID].DeploymentID.ValueString())
+    })
+
+    for _, workflowID := range sortedKeys(errs) {
+        resp.Diagnostics.AddError(
+            "Error deleting workflow deployment",
+            fmt.Sprintf("Could not undeploy workflow %s, unexpected error: %s", workflowID, errs[workflowID].Error()),
+        )
+    }
+}
+
+// deploy deploys the targeted workflows that changed, undeploys the ones that are no longer targeted,
+// and records the result in plan.Workflows.
+func (r *integrationDeploymentResource) deploy(ctx context.Context, plan *integrationDeploymentResourceModel, current map[string]integrationWorkflowDeploymentModel) diag.Diagnostics {
+    var diags diag.Diagnostics
+    projectID := plan.ProjectID.ValueString()
+
+    tags, tagDiags := integrationDeploymentTags(ctx, plan.Tags)
+    diags.Append(tagDiags...)
+    if diags.HasError() {
+        return diags
+    }
+
+    targets, err := r.targetWorkflows(ctx, projectID, plan.IntegrationID.ValueString(), tags)
+    if err != nil {
+        diags.AddError(
+            "Error reading workflows",
+            "Could not read the workflows of the integration, unexpected error: "+err.Error(),
+        )
+        return diags
+    }
+
+    if len(targets) == 0 {
+        diags.AddWarning(
+            "No workflows to deploy",
+            "No workflow of the integration matches the configuration, nothing is deployed.",
+        )
+    }
+
+    result := make(map[string]integrationWorkflowDeploymentModel)
+    var toDeploy, toUndeploy []string
+    for workflowID, version := range targets {
+        if integrationWorkflowNeedsDeployment(version, current[workflowID]) {
+            toDeploy = append(toDeploy, workflowID)
+        } else {
+            result[workflowID] = current[workflowID]
+        }
+    }
+    for workflowID := range current {
+        if _, ok := targets[workflowID]; !ok {
+            toUndeploy = append(toUndeploy, workflowID)
+        }
+    }
+    sort.Strings(toDeploy)
+    sort.Strings(toUndeploy)
+
+    var mu sync.Mutex
+    deployErrs := runConcurrently(toDeploy, plan.MaxConcurrency.ValueInt64(), func(workflowID string) error {
+        deploymentID, err := deployWorkflowAndWait(ctx, r.client, projectID, workflowID, "")
+
+        mu.Lock()
+        defer mu.Unlock()
+        if err != nil {
+            // Keep what was deployed before, the failed status makes the next apply retry
+            failed, ok := current[workflowID]
+            if !ok {
+                failed = integrationWorkflowDeploymentModel{
+                    Version:      types.Int64Null(),
+                    DeploymentID: types.StringValue(""),
+                }
+            }
+            failed.Status = types.StringValue(integrationWorkflowStatusFailed)
+            result[workflowID] = failed
+            return err
+        }
+        result[workflowID] = integrationWorkflowDeploymentModel{
+            Version:      types.Int64Value(targets[workflowID]),
+            DeploymentID: types.StringValue(deploymentID),
+            Status:       types.StringValue(client.WorkflowDeploymentStatusDeployed),
+        }
+        return nil
+    })
+
+    undeployErrs := runConcurrently(toUndeploy, plan.MaxConcurrency.ValueInt64(), func(workflowID string) error {
+        err := undeployWorkflowAndWait(ctx, r.client, projectID, workflowID, current[workflowID].DeploymentID.ValueString())
+        if err != nil {
+            // Keep tracking the workflow so the undeployment is retried
+            mu.Lock()
+            result[workflowID] = current[workflowID]
+            mu.Unlock()
+        }
+        return err
+    })
+
+    for _, workflowID := range sortedKeys(deployErrs) {
+        diags.AddError(
+            "Error deploying workflow",
+            fmt.Sprintf("Could not deploy workflow %s, unexpected error: %s", workflowID, deployErrs[workflowID].Error()),
+        )
+    }
+    for _, workflowID := range sortedKeys(undeployErrs) {
+        diags.AddError(
+            "Error undeploying workflow",
+            fmt.Sprintf("Could not undeploy workflow %s, unexpected error: %s", workflowID, undeployErrs[workflowID].Error()),
+        )
+    }
+
+    workflows, mapDiags := types.MapValueFrom(ctx, integrationWorkflowDeploymentType, result)
+    diags.Append(mapDiags...)
+    plan.Workflows = workflows
+
+    return diags
+}
+
+// targetWorkflows returns the versions of the integration workflows to deploy, keyed by workflow ID.
+func (r *integrationDeploymentResource) targetWorkflows(ctx context.Context, projectID, integrationID string, tags []string) (map[string]int64, error) {
+    workflows, err := r.client.GetWorkflows(ctx, projectID, integrationID)
+    if err != nil {
+        return nil, err
+    }
+
+    targets := make(map[string]int64)
+    for _, workflow := range workflows {
+        if len(tags) > 0 && !hasAnyTag(workflow.Tags, tags) {
+            continue
+        }
+        targets[workflow.ID] = int64(workflow.WorkflowVersion)
+    }
+
+    return targets, nil
+}
+
+// integrationWorkflowNeedsDeployment reports whether a workflow is not deployed at the given version.
+func integrationWorkflowNeedsDeployment(version int64, current integrationWorkflowDeploymentModel) bool {
+    return current.Status.ValueString() != client.WorkflowDeploymentStatusDeployed ||
+        current.Version.IsNull() ||
+        current.Version.ValueInt64() != version
+}
+
+// integrationDeploymentTags converts the tags filter, which may be null.
+func integrationDeploymentTags(ctx context.Context, tagsSet types.Set) ([]string, diag.Diagnostics) {
+    var tags []string
+    if tagsSet.IsNull() {
+        return tags, nil
+    }
+    diags := tagsSet.ElementsAs(ctx, &tags, false)
+    return tags, diags
+}
+
+// integrationWorkflowDeployments converts the workflows map, which may be null or unknown.
+func integrationWorkflowDeployments(ctx context.Context, workflowsMap types.Map) (map[string]integrationWorkflowDeploymentModel, diag.Diagnostics) {
+    workflows := make(map[string]integrationWorkflowDeploymentModel)
+    if workflowsMap.IsNull() || workflowsMap.IsUnknown() {
+        return workflows, nil
+    }
+    diags := workflowsMap.ElementsAs(ctx, &workflows, false)
+    return workflows, diags
+}
+
+// hasAnyTag reports whether at least one of the wanted tags is in tags.
+func hasAnyTag(tags []string, wanted []string) bool {
+    for _, tag := range tags {
+        for _, want := range wanted {
+            if tag == want {
+                return true
+            }
+        }
+    }
+    return false
+}
+
+// runConcurrently calls fn for every ID with at most limit calls running at the same time,
+// and returns the errors keyed by ID.
+func runConcurrently(ids []string, limit int64, fn func(id string) error) map[string]error {
+    if limit < 1 {
+        limit = 1
+    }
+
+    var (
+        wg   sync.WaitGroup
+        mu   sync.Mutex
+        errs = make(map[string]error)
+        sem  = make(chan struct{}, limit)
+    )
+
+    for _, id := range ids {
+        wg.Add(1)
+        sem <- struct{}{}
+        go func(id string) {
+            defer wg.Done()
+            defer func() { <-sem }()
+
+            if err := fn(id); err != nil {
+                mu.Lock()
+                errs[id] = err
+                mu.Unlock()
+            }
+        }(id)
+    }
+
+    wg.Wait()
+    return errs
+}
+
+// sortedKeys returns the keys of a map in a stable order, for deterministic diagnostics.
+func sortedKeys[V any](m map[string]V) []string {
+    keys := make([]string, 0, len(m))
+    for key := range m {
+        keys = append(keys, key)
+    }
+    sort.Strings(keys)
+    return keys
+}
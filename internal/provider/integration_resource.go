@@ -0,0 +1,280 @@
+package provider
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework/resource"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ resource.Resource              = &integrationResource{}
+    _ resource.ResourceWithConfigure = &integrationResource{}
+)
+
+// NewIntegrationResource is a helper function to simplify the provider implementation.
+func NewIntegrationResource() resource.Resource {
+    return &integrationResource{}
+}
+
+// integrationResource is the resource implementation.
+type integrationResource struct {
+    client *client.Client
+}
+
+// integrationResourceModel maps the resource schema data.
+type integrationResourceModel struct {
+    ID                  types.String `tfsdk:"id"`
+    ProjectID           types.String `tfsdk:"project_id"`
+    Type                types.String `tfsdk:"type"`
+    CustomIntegrationID types.String `tfsdk:"custom_integration_id"`
+    IsActive            types.Bool   `tfsdk:"is_active"`
+    OnDestroy           types.String `tfsdk:"on_destroy"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *integrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    r.client = req.ProviderData.(*client.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Adds a catalog or custom integration to a project.",
+        Attributes: map[string]schema.Attribute{
+            "id": schema.StringAttribute{
+                Description: "Identifier of the integration.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                },
+            },
+            "project_id": schema.StringAttribute{
+                Description: "Identifier of the project.",
+                Required:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "type": schema.StringAttribute{
+                Description: "Type of the catalog integration to add (e.g. slack). Exactly one of type and custom_integration_id must be set: for a custom integration, type must not be set and is computed as the slug of the custom integration.",
+                Optional:    true,
+                Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                    stringplanmodifier.RequiresReplace(),
+                },
+                Validators: []validator.String{
+                    stringvalidator.ExactlyOneOf(path.MatchRoot("custom_integration_id")),
+                    stringvalidator.NoneOf("custom"),
+                },
+            },
+            "custom_integration_id": schema.StringAttribute{
+                Description: "Identifier of the custom integration to add.",
+                Optional:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.RequiresReplace(),
+                },
+            },
+            "is_active": schema.BoolAttribute{
+                Description: "Indicates if the integration is active. Use paragon_integration_status to manage it.",
+                Computed:    true,
+            },
+            "on_destroy": onDestroyAttribute("integration", "removes it from the project", "deactivates it"),
+        },
+    }
+}
+
+// integrationTypeName returns the type of an integration, the slug for custom integrations.
+func integrationTypeName(integration *client.Integration) string {
+    if integration.Type == "custom" && integration.CustomIntegration != nil {
+        return integration.CustomIntegration.Slug
+    }
+    return integration.Type
+}
+
+// setIntegrationState maps the integration to the model.
+func setIntegrationState(model *integrationResourceModel, integration *client.Integration) {
+    model.ID = types.StringValue(integration.ID)
+    model.IsActive = types.BoolValue(integration.IsActive)
+    if typeName := integrationTypeName(integration); typeName != "" {
+        model.Type = types.StringValue(typeName)
+    }
+    if integration.CustomIntegrationID != nil {
+        model.CustomIntegrationID = types.StringValue(*integration.CustomIntegrationID)
+    }
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    var plan integrationResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    projectID := plan.ProjectID.ValueString()
+    createReq := client.CreateIntegrationRequest{Type: plan.Type.ValueString()}
+    if !plan.CustomIntegrationID.IsNull() {
+        createReq = client.CreateIntegrationRequest{Type: "custom", CustomIntegrationID: plan.CustomIntegrationID.ValueString()}
+    }
+
+    // A project has a single integration per type, fail clearly instead of with the API conflict
+    integrations, err := r.client.GetIntegrations(ctx, projectID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading integrations",
+            "Could not read the integrations of the project, unexpected error: "+err.Error(),
+        )
+        return
+    }
+    for _, integration := range integrations {
+        exists := integration.Type == createReq.Type && createReq.CustomIntegrationID == ""
+        if createReq.CustomIntegrationID != "" {
+            exists = integration.CustomIntegrationID != nil && *integration.CustomIntegrationID == createReq.CustomIntegrationID
+        }
+        if exists {
+            resp.Diagnostics.AddError(
+                "Integration already exists",
+                fmt.Sprintf("The project already has the %s integration (ID %s). Reference it with the paragon_integrations data source, or remove it from the project first.",
+                    integrationTypeName(&integration), integration.ID),
+            )
+            return
+        }
+    }
+
+    integration, err := r.client.CreateIntegration(ctx, projectID, createReq)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating integration",
+            "Could not create integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
+    setIntegrationState(&plan, integration)
+
+    // Set state to fully populated data
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+    var state integrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    integration, err := r.client.GetIntegration(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+    if err != nil {
+        if strings.Contains(err.Error(), "status code: 404") {
+            resp.State.RemoveResource(ctx)
+        } else {
+            resp.Diagnostics.AddError(
+                "Error retrieving integration",
+                "Could not retrieve integration, unexpected error: "+err.Error(),
+            )
+        }
+        return
+    }
+
+    setIntegrationState(&state, integration)
+
+    // Set the refreshed state
+    diags = resp.State.Set(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+    var plan integrationResourceModel
+    diags := req.Plan.Get(ctx, &plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    var state integrationResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // Every other attribute replaces the integration, only on_destroy can change here
+    plan.ID = state.ID
+    plan.Type = state.Type
+    plan.IsActive = state.IsActive
+
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+    var state integrationResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "integration", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
+    projectID := state.ProjectID.ValueString()
+    integrationID := state.ID.ValueString()
+
+    if state.OnDestroy.ValueString() == onDestroyDeactivate {
+        _, err := r.client.UpdateIntegrationStatus(ctx, projectID, integrationID, false)
+        if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+            resp.Diagnostics.AddError(
+                "Error updating integration status",
+                "Could not deactivate integration, unexpected error: "+err.Error(),
+            )
+        }
+        return
+    }
+
+    err := r.client.DeleteIntegration(ctx, projectID, integrationID)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error deleting integration",
+            "Could not delete integration, unexpected error: "+err.Error(),
+        )
+        return
+    }
+}
@@ -35,6 +35,7 @@ type integrationStatusResourceModel struct {
     ProjectID     types.String `tfsdk:"project_id"`
     IntegrationID types.String `tfsdk:"integration_id"`
     Active        types.Bool   `tfsdk:"active"`
+    OnDestroy     types.String `tfsdk:"on_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -78,6 +79,7 @@ func (r *integrationStatusResource) Schema(_ context.Context, _ resource.SchemaR
                 Description: "Indicates whether the integration is active or not.",
                 Required:    true,
             },
+            "on_destroy": onDestroyAttribute("integration", "", "deactivates the integration"),
         },
     }
 }
@@ -133,6 +135,8 @@ func (r *integrationStatusResource) Read(ctx context.Context, req resource.ReadR
         return
     }
 
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDeactivate)
+
     projectID := state.ProjectID.ValueString()
     integrationID := state.IntegrationID.ValueString()
 
@@ -213,6 +217,10 @@ func (r *integrationStatusResource) Delete(ctx context.Context, req resource.Del
         return
     }
 
+    if abandonOnDestroy(state.OnDestroy, "integration", state.IntegrationID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ProjectID.ValueString()
     integrationID := state.IntegrationID.ValueString()
 
@@ -22,6 +22,7 @@ import (
 var (
     _ resource.Resource              = &projectResource{}
     _ resource.ResourceWithConfigure = &projectResource{}
+    _ resource.ResourceWithValidateConfig = &projectResource{}
 )
 
 // NewProjectResource is a helper function to simplify the provider implementation.
@@ -45,6 +46,9 @@ type projectResourceModel struct {
     IsHidden          types.Bool   `tfsdk:"is_hidden"`
     AutomateProjectID types.String `tfsdk:"automate_project_id"`
     DuplicateNameAllowed types.Bool `tfsdk:"duplicate_name_allowed"`
+    AdoptExisting        types.Bool `tfsdk:"adopt_existing"`
+    DeletionProtection   types.Bool `tfsdk:"deletion_protection"`
+    OnDestroy            types.String `tfsdk:"on_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -74,6 +78,11 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Description: "Indicates whether creating another project with the same name is allowed. Default=false.",
                 Optional:    true,
             },
+            "adopt_existing": schema.BoolAttribute{
+                Description: "When a project with the same title already exists in any team of the organization (or in team_id when set), adopt it instead of failing. Cannot be true together with duplicate_name_allowed. Default=false.",
+                Optional:    true,
+            },
+            "deletion_protection": deletionProtectionAttribute("project"),
             "organization_id": schema.StringAttribute{
                 Description: "Identifier of the organization.",
                 Required:    true,
@@ -93,8 +102,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Computed:    true,
             },
             "team_id": schema.StringAttribute{
-                Description: "Identifier of the team associated with the project.",
+                Description: "Identifier of the team associated with the project. When set, the project is created in this existing team, otherwise a new team is created for it.",
+                Optional:    true,
                 Computed:    true,
+                PlanModifiers: []planmodifier.String{
+                    stringplanmodifier.UseStateForUnknown(),
+                    stringplanmodifier.RequiresReplace(),
+                },
             },
             "is_connect_project": schema.BoolAttribute{
                 Description: "Indicates if the project is a Connect project.",
@@ -108,10 +122,46 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Description: "Identifier of the automate project (older project) - can be empty.",
                 Computed:    true,
             },
+            "on_destroy": onDestroyAttribute("project", "deletes the project and its automate project", "hides the project in the dashboard"),
         },
     }
 }
 
+// ValidateConfig rejects adopting an existing project while duplicate names are allowed, as the two are contradictory,
+// and warns when an adopted project would be deleted on destroy.
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var duplicateNameAllowed, adoptExisting, deletionProtection types.Bool
+    var onDestroy types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duplicate_name_allowed"), &duplicateNameAllowed)...)
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting)...)
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if duplicateNameAllowed.ValueBool() && adoptExisting.ValueBool() {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("adopt_existing"),
+            "Conflicting project attributes",
+            "adopt_existing cannot be true when duplicate_name_allowed is true.",
+        )
+        return
+    }
+
+    // An unset on_destroy defaults to delete
+    deletesOnDestroy := onDestroy.IsNull() || onDestroy.ValueString() == onDestroyDelete
+    if adoptExisting.ValueBool() && deletesOnDestroy && !deletionProtection.ValueBool() {
+        resp.Diagnostics.AddAttributeWarning(
+            path.Root("adopt_existing"),
+            "Adopted project is deleted on destroy",
+            "A project adopted with adopt_existing was not created by Terraform, yet destroying the resource deletes it and its "+
+                "automate project, with their integrations, workflows and connected users. "+
+                "Set on_destroy = \"abandon\" (or \"deactivate\") or deletion_protection = true to keep them.",
+        )
+    }
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     // Retrieve values from plan
@@ -128,47 +178,61 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		duplicateNameAllowed = plan.DuplicateNameAllowed.ValueBool()
 	}
 
+    // An existing team was requested for the project
+    existingTeamID := ""
+    if !plan.TeamID.IsNull() && !plan.TeamID.IsUnknown() {
+        existingTeamID = plan.TeamID.ValueString()
+    }
+
+    adoptExisting := !plan.AdoptExisting.IsNull() && !plan.AdoptExisting.IsUnknown() && plan.AdoptExisting.ValueBool()
+
     // If duplicate names are not allowed, check if a project with the same name already exists
-    if !duplicateNameAllowed {
-        // Get the list of teams
-        teams, err := r.client.GetTeams(ctx)
+    if !duplicateNameAllowed || adoptExisting {
+        existingProject, existingAutomateProject, err := r.findProjectByTitle(ctx, plan.OrganizationID.ValueString(), existingTeamID, plan.Title.ValueString(), adoptExisting)
         if err != nil {
             resp.Diagnostics.AddError(
-                "Error retrieving teams",
-                "Could not retrieve teams, unexpected error: "+err.Error(),
+                "Error retrieving projects",
+                "Could not retrieve projects, unexpected error: "+err.Error(),
             )
             return
         }
 
-        if len(teams) > 0 {
-            // Take the ID of the first team found
-            teamID := teams[0].ID
-
-            // Get the list of projects for the team
-            projects, err := r.client.GetProjects(ctx, teamID)
-            if err != nil {
+        if existingProject != nil {
+            if !adoptExisting {
                 resp.Diagnostics.AddError(
-                    "Error retrieving projects",
-                    "Could not retrieve projects, unexpected error: "+err.Error(),
+                    "Project already exists",
+                    fmt.Sprintf("A project with the name '%s' already exists in team %s, set adopt_existing to manage it", plan.Title.ValueString(), existingProject.TeamID),
                 )
                 return
             }
 
-            // Check if a project with the same name already exists
-            for _, project := range projects {
-                if project.Title == plan.Title.ValueString() && project.IsConnectProject {
-                    resp.Diagnostics.AddError(
-                        "Project already exists",
-                        fmt.Sprintf("A project with the name '%s' already exists", plan.Title.ValueString()),
-                    )
-                    return
-                }
+            tflog.Info(ctx, "Adopting existing project", map[string]interface{}{"project_id": existingProject.ID, "team_id": existingProject.TeamID})
+
+            plan.ID = types.StringValue(existingProject.ID)
+            plan.Title = types.StringValue(existingProject.Title)
+            plan.OwnerID = types.StringValue(existingProject.OwnerID)
+            plan.TeamID = types.StringValue(existingProject.TeamID)
+            plan.IsConnectProject = types.BoolValue(existingProject.IsConnectProject)
+            plan.IsHidden = types.BoolValue(existingProject.IsHidden)
+            plan.AutomateProjectID = types.StringValue("")
+            if existingAutomateProject != nil {
+                plan.AutomateProjectID = types.StringValue(existingAutomateProject.ID)
             }
+
+            diags = resp.State.Set(ctx, plan)
+            resp.Diagnostics.Append(diags...)
+            return
         }
     }
 
-    // Create new project
-    project, olderProject, err := r.client.CreateProject(ctx, plan.OrganizationID.ValueString(), plan.Title.ValueString())
+    // Create new project, either in the requested team or in a new team
+    var project, olderProject *client.Project
+    var err error
+    if existingTeamID != "" {
+        project, err = r.client.CreateProjectInTeam(ctx, existingTeamID, plan.Title.ValueString())
+    } else {
+        project, olderProject, err = r.client.CreateProject(ctx, plan.OrganizationID.ValueString(), plan.Title.ValueString())
+    }
     if err != nil {
         resp.Diagnostics.AddError(
             "Error creating project",
@@ -211,6 +275,8 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
         return
     }
 
+    defaultDestroyAttributes(&state.DeletionProtection, &state.OnDestroy, onDestroyDelete)
+
     projectID := state.ID.ValueString()
     teamID := state.TeamID.ValueString()
 
@@ -246,11 +312,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
     state.TeamID = types.StringValue(foundProject.TeamID)
     state.IsConnectProject = types.BoolValue(foundProject.IsConnectProject)
     state.IsHidden = types.BoolValue(foundProject.IsHidden)
-    state.AutomateProjectID = state.AutomateProjectID
 
-    // We will take that from state and not read it from server. as this is an unimportant project,
+    // AutomateProjectID is taken from state and not read from server. as this is an unimportant project,
     // we keep this just for deletion purposes.
-    state.AutomateProjectID = state.AutomateProjectID
 
     // Set the refreshed state
     diags = resp.State.Set(ctx, &state)
@@ -290,6 +354,14 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
     projectID := state.ID.ValueString()
     teamID := state.TeamID.ValueString()
 
+    // Keep the computed attributes when only flags changed
+    plan.ID = state.ID
+    plan.OwnerID = state.OwnerID
+    plan.TeamID = state.TeamID
+    plan.IsConnectProject = state.IsConnectProject
+    plan.IsHidden = state.IsHidden
+    plan.AutomateProjectID = state.AutomateProjectID
+
     // Check if the name has changed
     if !plan.Title.Equal(state.Title) {
         // Update the project title using the UpdateProjectTitle function
@@ -338,10 +410,31 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
         return
     }
 
+    resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "project", state.ID.ValueString())...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "project", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ID.ValueString()
     automateProjectID := state.AutomateProjectID.ValueString()
     teamID := state.TeamID.ValueString()
 
+    // Only hide the project, keeping its integrations, workflows and connected users
+    if state.OnDestroy.ValueString() == onDestroyDeactivate {
+        _, err := r.client.UpdateProjectHidden(ctx, projectID, teamID, true)
+        if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+            resp.Diagnostics.AddError(
+                "Error hiding project",
+                "Could not hide project, unexpected error: "+err.Error(),
+            )
+        }
+        return
+    }
+
     // Delete the project using the DeleteProject function
     err := r.client.DeleteProject(ctx, projectID, teamID)
     if err != nil {
@@ -367,4 +460,55 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
             }
         }
     }
-}
\ No newline at end of file
+}
+
+// findProjectByTitle looks for a connect project with the given title in the given team, and for the automate (older)
+// project created with it in the same team. Without a team, every team of the organization is searched when allTeams is
+// set (to adopt a project), otherwise only the first team, as the duplicate name check always did.
+func (r *projectResource) findProjectByTitle(ctx context.Context, organizationID, teamID, title string, allTeams bool) (*client.Project, *client.Project, error) {
+    var teamIDs []string
+    if teamID != "" {
+        teamIDs = []string{teamID}
+    } else {
+        teams, err := r.client.GetTeams(ctx)
+        if err != nil {
+            return nil, nil, err
+        }
+        if allTeams {
+            for _, team := range teams {
+                if team.OrganizationID == "" || team.OrganizationID == organizationID {
+                    teamIDs = append(teamIDs, team.ID)
+                }
+            }
+        } else if len(teams) > 0 {
+            teamIDs = []string{teams[0].ID}
+        }
+    }
+
+    for _, id := range teamIDs {
+        projects, err := r.client.GetProjects(ctx, id)
+        if err != nil {
+            return nil, nil, err
+        }
+
+        var project, automateProject *client.Project
+        for i := range projects {
+            if projects[i].Title != title {
+                continue
+            }
+            if projects[i].IsConnectProject {
+                if project == nil {
+                    project = &projects[i]
+                }
+            } else if automateProject == nil {
+                automateProject = &projects[i]
+            }
+        }
+
+        if project != nil {
+            return project, automateProject, nil
+        }
+    }
+
+    return nil, nil, nil
+}
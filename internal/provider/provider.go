@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +17,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &paragonProvider{}
+	_ provider.Provider              = &paragonProvider{}
+	_ provider.ProviderWithFunctions = &paragonProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -143,9 +145,17 @@ func (p *paragonProvider) DataSources(_ context.Context) []func() datasource.Dat
         NewOrganizationDataSource,
         NewTeamsDataSource,
         NewTeamDataSource,
+        NewIntegrationDataSource,
         NewIntegrationsDataSource,
+        NewIntegrationCatalogDataSource,
         NewWorkflowDataSource,
         NewWorkflowsDataSource,
+        NewCLIKeysDataSource,
+        NewConnectedUsersDataSource,
+        NewCurrentUserDataSource,
+        NewWorkflowDeploymentHistoryDataSource,
+        NewEnvironmentSecretReferencesDataSource,
+        NewEnvironmentSecretsDataSource,
     }
 }
 
@@ -153,13 +163,26 @@ func (p *paragonProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *paragonProvider) Resources(_ context.Context) []func() resource.Resource {
     return []func() resource.Resource{
         NewProjectResource,
+        NewTeamResource,
         NewSDKKeysResource,
         NewEnvironmentSecretResource,
+        NewEnvironmentSecretsResource,
         NewTeamMemberResource,
         NewCLIKeyResource,
+        NewIntegrationResource,
+        NewIntegrationConfigResource,
         NewIntegrationCredentialsResource,
         NewIntegrationStatusResource,
         NewEventsDestinationResource,
         NewWorkflowDeploymentResource,
+        NewIntegrationDeploymentResource,
+        NewConnectedUserResource,
     }
-}
\ No newline at end of file
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *paragonProvider) Functions(_ context.Context) []func() function.Function {
+    return []func() function.Function{
+        NewSignUserTokenFunction,
+    }
+}
@@ -3,20 +3,28 @@ package provider
 
 import (
     "context"
+    "fmt"
+    "regexp"
     "strings"
+    "time"
 
+    "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/hashicorp/terraform-plugin-log/tflog"
     "github.com/arielb135/terraform-provider-paragon/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-    _ resource.Resource              = &sdkKeysResource{}
-    _ resource.ResourceWithConfigure = &sdkKeysResource{}
+    _ resource.Resource                = &sdkKeysResource{}
+    _ resource.ResourceWithConfigure   = &sdkKeysResource{}
+    _ resource.ResourceWithModifyPlan  = &sdkKeysResource{}
 )
 
 // NewSDKKeysResource is a helper function to simplify the provider implementation.
@@ -31,13 +39,77 @@ type sdkKeysResource struct {
 
 // sdkKeysResourceModel maps the resource schema data.
 type sdkKeysResourceModel struct {
-    ID            types.String `tfsdk:"id"`
-    ProjectID     types.String `tfsdk:"project_id"`
-    AuthType      types.String `tfsdk:"auth_type"`
-    Revoked       types.Bool   `tfsdk:"revoked"`
-    GeneratedDate types.String `tfsdk:"generated_date"`
-    PrivateKey    types.String `tfsdk:"private_key"`
-    Version       types.String `tfsdk:"version"`
+    ID                   types.String `tfsdk:"id"`
+    ProjectID            types.String `tfsdk:"project_id"`
+    AuthType             types.String `tfsdk:"auth_type"`
+    Revoked              types.Bool   `tfsdk:"revoked"`
+    GeneratedDate        types.String `tfsdk:"generated_date"`
+    PrivateKey           types.String `tfsdk:"private_key"`
+    Version              types.String `tfsdk:"version"`
+    RotationGracePeriod  types.String `tfsdk:"rotation_grace_period"`
+    KeepPreviousKey      types.Bool   `tfsdk:"keep_previous_key"`
+    PreviousKeyID        types.String `tfsdk:"previous_key_id"`
+    PreviousPrivateKey   types.String `tfsdk:"previous_private_key"`
+    PreviousKeyExpiresAt types.String `tfsdk:"previous_key_expires_at"`
+    PublicKey            types.String `tfsdk:"public_key"`
+    KeyID                types.String `tfsdk:"key_id"`
+    PreviousPublicKey    types.String `tfsdk:"previous_public_key"`
+    SigningAlgorithm     types.String `tfsdk:"signing_algorithm"`
+    JWKS                 types.String `tfsdk:"jwks"`
+    DeletionProtection   types.Bool   `tfsdk:"deletion_protection"`
+    OnDestroy            types.String `tfsdk:"on_destroy"`
+}
+
+// rotationEnabled reports whether a version change should rotate the key in place instead of replacing it.
+func (m sdkKeysResourceModel) rotationEnabled() bool {
+    return (!m.RotationGracePeriod.IsNull() && m.RotationGracePeriod.ValueString() != "") || m.KeepPreviousKey.ValueBool()
+}
+
+// previousKeyExpired reports whether the previous key is no longer needed.
+func (m sdkKeysResourceModel) previousKeyExpired(now time.Time) bool {
+    if m.KeepPreviousKey.ValueBool() {
+        return false
+    }
+
+    if m.PreviousKeyExpiresAt.IsNull() || m.PreviousKeyExpiresAt.ValueString() == "" {
+        return true
+    }
+
+    expiresAt, err := time.Parse(time.RFC3339, m.PreviousKeyExpiresAt.ValueString())
+    if err != nil {
+        return true
+    }
+
+    return !now.Before(expiresAt)
+}
+
+// setSigningAttributes derives key_id and jwks from the public keys of the current and previous keys.
+func (m *sdkKeysResourceModel) setSigningAttributes() error {
+    m.SigningAlgorithm = types.StringValue(client.SDKKeySigningAlgorithm)
+    m.KeyID = types.StringValue("")
+
+    var publicKeys []string
+    if m.PublicKey.ValueString() != "" {
+        jwk, err := client.PublicKeyToJWK(m.PublicKey.ValueString())
+        if err != nil {
+            return err
+        }
+        m.KeyID = types.StringValue(jwk.Kid)
+        publicKeys = append(publicKeys, m.PublicKey.ValueString())
+    }
+
+    // Keep the previous key in the key set while it is still active
+    if m.PreviousPublicKey.ValueString() != "" {
+        publicKeys = append(publicKeys, m.PreviousPublicKey.ValueString())
+    }
+
+    jwks, err := client.PublicKeysToJWKS(publicKeys)
+    if err != nil {
+        return err
+    }
+    m.JWKS = types.StringValue(jwks)
+
+    return nil
 }
 
 // Configure adds the provider configured client to the resource.
@@ -88,16 +160,81 @@ func (r *sdkKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, re
                 Sensitive:   true,
             },
             "version": schema.StringAttribute{
-                Description: "Version of the SDK key.",
+                Description: "Version of the SDK key. Changing it recreates the key, or rotates it in place when rotation is configured.",
                 Required:    true,
                 PlanModifiers: []planmodifier.String{
-                    stringplanmodifier.RequiresReplace(),
+                    stringplanmodifier.RequiresReplaceIf(
+                        sdkKeyVersionRequiresReplace,
+                        "Replaces the key when rotation is not configured.",
+                        "Replaces the key when rotation is not configured.",
+                    ),
+                },
+            },
+            "rotation_grace_period": schema.StringAttribute{
+                Description: "Duration (e.g. `24h`) the previous key stays active after a version change. Setting it enables in place rotation.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.RegexMatches(
+                        regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`),
+                        "Must be a valid duration, e.g. 30m or 24h",
+                    ),
                 },
             },
+            "keep_previous_key": schema.BoolAttribute{
+                Description: "Keeps the previous key active after a version change until this flag is cleared. Setting it enables in place rotation.",
+                Optional:    true,
+            },
+            "previous_key_id": schema.StringAttribute{
+                Description: "Identifier of the previous SDK key, while it is still active.",
+                Computed:    true,
+            },
+            "previous_private_key": schema.StringAttribute{
+                Description: "Private key of the previous SDK key, while it is still active.",
+                Computed:    true,
+                Sensitive:   true,
+            },
+            "previous_key_expires_at": schema.StringAttribute{
+                Description: "Date when the previous SDK key is deleted on the next apply, empty when it is kept until keep_previous_key is cleared.",
+                Computed:    true,
+            },
+            "public_key": schema.StringAttribute{
+                Description: "PEM encoded public key of the SDK key, used to verify the signed user tokens.",
+                Computed:    true,
+            },
+            "key_id": schema.StringAttribute{
+                Description: "Key ID of the SDK key - the RFC 7638 thumbprint of its public key, also used as the kid in jwks.",
+                Computed:    true,
+            },
+            "previous_public_key": schema.StringAttribute{
+                Description: "PEM encoded public key of the previous SDK key, while it is still active.",
+                Computed:    true,
+            },
+            "signing_algorithm": schema.StringAttribute{
+                Description: "Algorithm used to sign user tokens with the SDK key.",
+                Computed:    true,
+            },
+            "jwks": schema.StringAttribute{
+                Description: "JSON Web Key Set with the public keys of the current and previous SDK keys, for JWT verification configuration.",
+                Computed:    true,
+            },
+            "deletion_protection": deletionProtectionAttribute("SDK key"),
+            "on_destroy": onDestroyAttribute("SDK key", "deletes the key and the previous key", ""),
         },
     }
 }
 
+// sdkKeyVersionRequiresReplace replaces the key on version change unless rotation is configured.
+func sdkKeyVersionRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+    var gracePeriod types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotation_grace_period"), &gracePeriod)...)
+
+    var keepPrevious types.Bool
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("keep_previous_key"), &keepPrevious)...)
+
+    model := sdkKeysResourceModel{RotationGracePeriod: gracePeriod, KeepPreviousKey: keepPrevious}
+    resp.RequiresReplace = !model.rotationEnabled()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *sdkKeysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan sdkKeysResourceModel
@@ -127,6 +264,18 @@ func (r *sdkKeysResource) Create(ctx context.Context, req resource.CreateRequest
     plan.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
     plan.PrivateKey = types.StringValue(sdkKey.PrivateKey)
     plan.Version = types.StringValue(version)
+    plan.PreviousKeyID = types.StringNull()
+    plan.PreviousPrivateKey = types.StringNull()
+    plan.PreviousKeyExpiresAt = types.StringNull()
+    plan.PublicKey = types.StringValue(sdkKey.AuthConfig.Paragon.PublicKey)
+    plan.PreviousPublicKey = types.StringNull()
+    if err := plan.setSigningAttributes(); err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading SDK public key",
+            "Could not derive signing attributes from the SDK public key, unexpected error: "+err.Error(),
+        )
+        return
+    }
 
     // Set state to fully populated data
     diags = resp.State.Set(ctx, plan)
@@ -144,6 +293,8 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
         return
     }
 
+    defaultDestroyAttributes(&state.DeletionProtection, &state.OnDestroy, onDestroyDelete)
+
     projectID := state.ProjectID.ValueString()
 
     // Retrieve the SDK keys using the GetSDKKeys function
@@ -162,12 +313,14 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
         return
     }
 
-    // Find the SDK key with the matching ID
-    var sdkKey *client.SDKKey
-    for _, key := range sdkKeys {
-        if key.ID == state.ID.ValueString() {
-            sdkKey = &key
-            break
+    // Find the SDK key with the matching ID, and the previous key if one is still kept
+    var sdkKey, previousKey *client.SDKKey
+    for i := range sdkKeys {
+        if sdkKeys[i].ID == state.ID.ValueString() {
+            sdkKey = &sdkKeys[i]
+        }
+        if sdkKeys[i].ID == state.PreviousKeyID.ValueString() {
+            previousKey = &sdkKeys[i]
         }
     }
 
@@ -181,6 +334,27 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
     state.AuthType = types.StringValue(sdkKey.AuthType)
     state.Revoked = types.BoolValue(sdkKey.Revoked)
     state.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
+    state.PublicKey = types.StringValue(sdkKey.AuthConfig.Paragon.PublicKey)
+    state.PreviousPublicKey = types.StringNull()
+
+    // The previous key was deleted outside terraform
+    if !state.PreviousKeyID.IsNull() && previousKey == nil {
+        tflog.Debug(ctx, fmt.Sprintf("previous SDK key %s no longer exists", state.PreviousKeyID.ValueString()))
+        state.PreviousKeyID = types.StringNull()
+        state.PreviousPrivateKey = types.StringNull()
+        state.PreviousKeyExpiresAt = types.StringNull()
+    }
+    if previousKey != nil && !state.PreviousKeyID.IsNull() {
+        state.PreviousPublicKey = types.StringValue(previousKey.AuthConfig.Paragon.PublicKey)
+    }
+
+    if err := state.setSigningAttributes(); err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading SDK public key",
+            "Could not derive signing attributes from the SDK public key, unexpected error: "+err.Error(),
+        )
+        return
+    }
 
     // Set the refreshed state
     diags = resp.State.Set(ctx, &state)
@@ -190,7 +364,63 @@ func (r *sdkKeysResource) Read(ctx context.Context, req resource.ReadRequest, re
     }
 }
 
-// Update is not supported for this resource.
+// ModifyPlan plans in place rotations and the removal of a previous key that is no longer needed.
+func (r *sdkKeysResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to do on create or destroy
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var plan, state sdkKeysResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    // A rotation creates a new key and keeps the current one as the previous key
+    if !plan.Version.Equal(state.Version) {
+        plan.ID = types.StringUnknown()
+        plan.AuthType = types.StringUnknown()
+        plan.Revoked = types.BoolUnknown()
+        plan.GeneratedDate = types.StringUnknown()
+        plan.PrivateKey = types.StringUnknown()
+        plan.PreviousKeyID = types.StringUnknown()
+        plan.PreviousPrivateKey = types.StringUnknown()
+        plan.PreviousKeyExpiresAt = types.StringUnknown()
+        plan.PublicKey = types.StringUnknown()
+        plan.KeyID = types.StringUnknown()
+        plan.PreviousPublicKey = types.StringUnknown()
+        plan.JWKS = types.StringUnknown()
+        resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+        return
+    }
+
+    if state.PreviousKeyID.IsNull() {
+        return
+    }
+
+    // Expiry is evaluated against the planned keep_previous_key flag
+    state.KeepPreviousKey = plan.KeepPreviousKey
+    if state.previousKeyExpired(time.Now()) {
+        plan.PreviousKeyID = types.StringNull()
+        plan.PreviousPrivateKey = types.StringNull()
+        plan.PreviousKeyExpiresAt = types.StringNull()
+        plan.PreviousPublicKey = types.StringNull()
+        plan.JWKS = types.StringUnknown()
+        resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+        return
+    }
+
+    // Otherwise the previous key is kept as is
+    plan.PreviousKeyID = state.PreviousKeyID
+    plan.PreviousPrivateKey = state.PreviousPrivateKey
+    plan.PreviousKeyExpiresAt = state.PreviousKeyExpiresAt
+    plan.PreviousPublicKey = state.PreviousPublicKey
+    resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Update rotates the key in place or removes the previous key once it is no longer needed.
 func (r *sdkKeysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
     // Retrieve values from plan
     var plan sdkKeysResourceModel
@@ -208,6 +438,90 @@ func (r *sdkKeysResource) Update(ctx context.Context, req resource.UpdateRequest
         return
     }
 
+    projectID := state.ProjectID.ValueString()
+
+    // Carry over the current key, rotation below replaces it
+    plan.ID = state.ID
+    plan.AuthType = state.AuthType
+    plan.Revoked = state.Revoked
+    plan.GeneratedDate = state.GeneratedDate
+    plan.PrivateKey = state.PrivateKey
+    plan.PreviousKeyID = state.PreviousKeyID
+    plan.PreviousPrivateKey = state.PreviousPrivateKey
+    plan.PreviousKeyExpiresAt = state.PreviousKeyExpiresAt
+    plan.PublicKey = state.PublicKey
+    plan.PreviousPublicKey = state.PreviousPublicKey
+
+    rotate := !plan.Version.Equal(state.Version)
+
+    // Only one previous key is kept - drop it when rotating again or once it expired
+    check := state
+    check.KeepPreviousKey = plan.KeepPreviousKey
+    if !state.PreviousKeyID.IsNull() && (rotate || check.previousKeyExpired(time.Now())) {
+        tflog.Debug(ctx, fmt.Sprintf("Deleting previous SDK key %s", state.PreviousKeyID.ValueString()))
+        err := r.client.DeleteSDKKey(ctx, projectID, state.PreviousKeyID.ValueString())
+        if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+            resp.Diagnostics.AddError(
+                "Error deleting previous SDK key",
+                "Could not delete previous SDK key, unexpected error: "+err.Error(),
+            )
+            return
+        }
+        plan.PreviousKeyID = types.StringNull()
+        plan.PreviousPrivateKey = types.StringNull()
+        plan.PreviousKeyExpiresAt = types.StringNull()
+        plan.PreviousPublicKey = types.StringNull()
+    }
+
+    if rotate {
+        var gracePeriod time.Duration
+        if !plan.RotationGracePeriod.IsNull() && plan.RotationGracePeriod.ValueString() != "" {
+            var err error
+            gracePeriod, err = time.ParseDuration(plan.RotationGracePeriod.ValueString())
+            if err != nil {
+                resp.Diagnostics.AddAttributeError(
+                    path.Root("rotation_grace_period"),
+                    "Invalid rotation grace period",
+                    "Could not parse rotation grace period: "+err.Error(),
+                )
+                return
+            }
+        }
+
+        // Create the new key before the current one becomes the previous key
+        sdkKey, err := r.client.CreateSDKKey(ctx, projectID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Error rotating SDK key",
+                "Could not create SDK key, unexpected error: "+err.Error(),
+            )
+            return
+        }
+
+        plan.PreviousKeyID = state.ID
+        plan.PreviousPrivateKey = state.PrivateKey
+        plan.PreviousPublicKey = state.PublicKey
+        plan.PreviousKeyExpiresAt = types.StringValue("")
+        if gracePeriod > 0 {
+            plan.PreviousKeyExpiresAt = types.StringValue(time.Now().UTC().Add(gracePeriod).Format(time.RFC3339))
+        }
+
+        plan.ID = types.StringValue(sdkKey.ID)
+        plan.AuthType = types.StringValue(sdkKey.AuthType)
+        plan.Revoked = types.BoolValue(sdkKey.Revoked)
+        plan.GeneratedDate = types.StringValue(sdkKey.AuthConfig.Paragon.GeneratedDate)
+        plan.PrivateKey = types.StringValue(sdkKey.PrivateKey)
+        plan.PublicKey = types.StringValue(sdkKey.AuthConfig.Paragon.PublicKey)
+    }
+
+    if err := plan.setSigningAttributes(); err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading SDK public key",
+            "Could not derive signing attributes from the SDK public key, unexpected error: "+err.Error(),
+        )
+        return
+    }
+
     // Set the state to the current plan
     diags = resp.State.Set(ctx, plan)
     resp.Diagnostics.Append(diags...)
@@ -225,20 +539,34 @@ func (r *sdkKeysResource) Delete(ctx context.Context, req resource.DeleteRequest
         return
     }
 
+    resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "SDK key", state.ID.ValueString())...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if abandonOnDestroy(state.OnDestroy, "SDK key", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ProjectID.ValueString()
-    keyID := state.ID.ValueString()
+    keyIDs := []string{state.ID.ValueString()}
+    if !state.PreviousKeyID.IsNull() && state.PreviousKeyID.ValueString() != "" {
+        keyIDs = append(keyIDs, state.PreviousKeyID.ValueString())
+    }
 
-    // Delete the SDK key using the DeleteSDKKey function
-    err := r.client.DeleteSDKKey(ctx, projectID, keyID)
-    if err != nil {
-        // Check if the error message indicates a 404 Not Found status code
-        if strings.Contains(err.Error(), "status code: 404") {
+    // Delete the SDK keys using the DeleteSDKKey function
+    for _, keyID := range keyIDs {
+        err := r.client.DeleteSDKKey(ctx, projectID, keyID)
+        if err != nil {
+            // Check if the error message indicates a 404 Not Found status code
+            if strings.Contains(err.Error(), "status code: 404") {
+                continue
+            }
+            resp.Diagnostics.AddError(
+                "Error deleting SDK key",
+                "Could not delete SDK key, unexpected error: "+err.Error(),
+            )
             return
         }
-        resp.Diagnostics.AddError(
-            "Error deleting SDK key",
-            "Could not delete SDK key, unexpected error: "+err.Error(),
-        )
-        return
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+    "context"
+    "time"
+
+    "github.com/hashicorp/terraform-plugin-framework/function"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+    _ function.Function = &signUserTokenFunction{}
+)
+
+// NewSignUserTokenFunction is a helper function to simplify the provider implementation.
+func NewSignUserTokenFunction() function.Function {
+    return &signUserTokenFunction{}
+}
+
+// signUserTokenFunction is the function implementation.
+type signUserTokenFunction struct{}
+
+// Metadata returns the function name.
+func (f *signUserTokenFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+    resp.Name = "sign_user_token"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *signUserTokenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+    resp.Definition = function.Definition{
+        Summary:     "Signs a paragon user token.",
+        Description: "Signs an RS256 paragon user token (JWT) with the private key of a paragon_sdk_keys resource, in the format expected by the Connect SDK.",
+        Parameters: []function.Parameter{
+            function.StringParameter{
+                Name:        "private_key",
+                Description: "PEM encoded private key of the SDK key.",
+            },
+            function.StringParameter{
+                Name:        "user_id",
+                Description: "Identifier of the paragon user, set as the sub claim.",
+            },
+            function.StringParameter{
+                Name:        "issued_at",
+                Description: "RFC3339 timestamp the token is issued at, set as the iat claim. The token expires ttl after it.",
+            },
+            function.StringParameter{
+                Name:        "ttl",
+                Description: "Duration the token is valid for, e.g. 1h.",
+            },
+            function.MapParameter{
+                Name:           "metadata",
+                Description:    "User metadata set as the meta claim, can be null.",
+                ElementType:    types.StringType,
+                AllowNullValue: true,
+            },
+        },
+        Return: function.StringReturn{},
+    }
+}
+
+// Run signs the token.
+func (f *signUserTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+    var privateKey, userID, issuedAt, ttl string
+    var metadata map[string]string
+
+    resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &privateKey, &userID, &issuedAt, &ttl, &metadata))
+    if resp.Error != nil {
+        return
+    }
+
+    // The issue time is an argument rather than the clock, so the token is the same at plan and apply
+    issuedAtTime, err := time.Parse(time.RFC3339, issuedAt)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(2, "Invalid issued_at: "+err.Error())
+        return
+    }
+
+    duration, err := time.ParseDuration(ttl)
+    if err != nil {
+        resp.Error = function.NewArgumentFuncError(3, "Invalid ttl: "+err.Error())
+        return
+    }
+
+    token, err := client.SignUserToken(privateKey, userID, duration, metadata, issuedAtTime)
+    if err != nil {
+        resp.Error = function.NewFuncError("Could not sign user token: " + err.Error())
+        return
+    }
+
+    resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, token))
+}
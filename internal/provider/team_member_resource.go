@@ -22,6 +22,14 @@ import (
 var (
     _ resource.Resource              = &teamMemberResource{}
     _ resource.ResourceWithConfigure = &teamMemberResource{}
+    _ resource.ResourceWithModifyPlan = &teamMemberResource{}
+)
+
+// Values of the computed status attribute.
+const (
+    teamMemberStatusPending = "pending"
+    teamMemberStatusActive  = "active"
+    teamMemberStatusExpired = "expired"
 )
 
 // NewTeamMemberResource is a helper function to simplify the provider implementation.
@@ -40,6 +48,8 @@ type teamMemberResourceModel struct {
     TeamID         types.String `tfsdk:"team_id"`
     Email          types.String `tfsdk:"email"`
     Role           types.String `tfsdk:"role"`
+    Status         types.String `tfsdk:"status"`
+    OnDestroy      types.String `tfsdk:"on_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -94,6 +104,11 @@ func (r *teamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringvalidator.OneOf("ADMIN", "MEMBER", "SUPPORT"),
 				},
             },
+            "status": schema.StringAttribute{
+                Description: "Status of the team member (pending, active, expired). An expired invite is re-sent on the next apply.",
+                Computed:    true,
+            },
+            "on_destroy": onDestroyAttribute("team member", "removes the member or cancels the invite", ""),
         },
     }
 }
@@ -141,6 +156,20 @@ func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequ
     }
     for _, invite := range invites {
         if invite.Email == email {
+            // An expired invite is stale, remove it so it can be sent again
+            if strings.EqualFold(invite.Status, client.TeamInviteStatusExpired) {
+                tflog.Debug(ctx, fmt.Sprintf("Removing expired invite %s before inviting again", invite.ID))
+                err = r.client.DeleteTeamInvite(ctx, teamID, invite.ID)
+                if err != nil && err.Error() != "status code: 404" {
+                    resp.Diagnostics.AddError(
+                        "Error deleting expired team invite",
+                        "Could not delete expired team invite, unexpected error: "+err.Error(),
+                    )
+                    return
+                }
+                continue
+            }
+
             resp.Diagnostics.AddError(
                 "Team invite already exists",
                 fmt.Sprintf("A team invite for email '%s' already exists", email),
@@ -171,6 +200,7 @@ func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 
     // Map response body to schema and populate Computed attribute values
     plan.ID = types.StringValue(invite.ID)
+    plan.Status = types.StringValue(teamMemberStatusPending)
 
     // Set state to fully populated data
     diags = resp.State.Set(ctx, plan)
@@ -190,6 +220,8 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
         return
     }
 
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
     teamID := state.TeamID.ValueString()
     email := state.Email.ValueString()
 
@@ -212,9 +244,10 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
         if member.Email == email {
             tflog.Debug(ctx, "Found member email in regular list! Updating ID.")
 
-            // Update the state with the new ID
+            // Update the state with the new ID - once an invite is accepted we track the member instead
             state.ID = types.StringValue(member.ID)
             state.Role = types.StringValue(member.Role)
+            state.Status = types.StringValue(teamMemberStatusActive)
 
             // Set the refreshed state
             diags := resp.State.Set(ctx, &state)
@@ -243,10 +276,20 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 
     for _, invite := range invites {
         if invite.Email == email {
-            tflog.Debug(ctx, "Found member email in invites! All good.")
+            // An accepted invite without a matching member means the member was removed afterwards
+            if strings.EqualFold(invite.Status, client.TeamInviteStatusAccepted) {
+                continue
+            }
+
+            tflog.Debug(ctx, fmt.Sprintf("Found member email in invites with status %s.", invite.Status))
 
             // Map the invite data to the state
+            state.ID = types.StringValue(invite.ID)
             state.Role = types.StringValue(invite.Role)
+            state.Status = types.StringValue(teamMemberStatusPending)
+            if strings.EqualFold(invite.Status, client.TeamInviteStatusExpired) {
+                state.Status = types.StringValue(teamMemberStatusExpired)
+            }
 
             // Set the refreshed state
             diags = resp.State.Set(ctx, &state)
@@ -262,6 +305,29 @@ func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
     resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan plans a new invite when the current one has expired.
+func (r *teamMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to do on create or destroy
+    if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+        return
+    }
+
+    var state teamMemberResourceModel
+    diags := req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if state.Status.ValueString() != teamMemberStatusExpired {
+        return
+    }
+
+    // The invite will be sent again, which produces a new invite ID
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(teamMemberStatusPending))...)
+    resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
     var plan teamMemberResourceModel
@@ -282,6 +348,43 @@ func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
     email := state.Email.ValueString()
     role := plan.Role.ValueString()
 
+    // Re-send an expired invite, using the planned role
+    if state.Status.ValueString() == teamMemberStatusExpired {
+        tflog.Debug(ctx, fmt.Sprintf("Invite %s has expired, sending it again", state.ID.ValueString()))
+        err := r.client.DeleteTeamInvite(ctx, teamID, state.ID.ValueString())
+        if err != nil && err.Error() != "status code: 404" {
+            resp.Diagnostics.AddError(
+                "Error deleting expired team invite",
+                "Could not delete expired team invite, unexpected error: "+err.Error(),
+            )
+            return
+        }
+
+        invites, err := r.client.InviteTeamMember(ctx, teamID, role, email)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Error inviting team member",
+                "Could not invite team member, unexpected error: "+err.Error(),
+            )
+            return
+        }
+
+        if len(invites) == 0 {
+            resp.Diagnostics.AddError(
+                "Error inviting team member",
+                "No team invite was created",
+            )
+            return
+        }
+
+        plan.ID = types.StringValue(invites[0].ID)
+        plan.Status = types.StringValue(teamMemberStatusPending)
+
+        diags = resp.State.Set(ctx, plan)
+        resp.Diagnostics.Append(diags...)
+        return
+    }
+
     // Check if the team member exists in the invites
     tflog.Debug(ctx, "Searching invites...")
     invites, err := r.client.GetTeamInvites(ctx, teamID)
@@ -331,19 +434,24 @@ func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
                     return
                 }
 
-                // Update the state with the updated role
-                state.Role = types.StringValue(updatedMember.Role)
-
-                // Set the updated state
-                diags := resp.State.Set(ctx, &state)
-                resp.Diagnostics.Append(diags...)
-                if resp.Diagnostics.HasError() {
-                    return
-                }
+                member.Role = updatedMember.Role
             }
+
+            // Set the planned state, with the member ID and role from the API
+            plan.ID = types.StringValue(member.ID)
+            plan.Status = types.StringValue(teamMemberStatusActive)
+            plan.Role = types.StringValue(member.Role)
+            diags := resp.State.Set(ctx, plan)
+            resp.Diagnostics.Append(diags...)
             return
         }
     }
+
+    // Still a pending invite with an unchanged role
+    plan.ID = state.ID
+    plan.Status = state.Status
+    diags = resp.State.Set(ctx, plan)
+    resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -355,6 +463,10 @@ func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
         return
     }
 
+    if abandonOnDestroy(state.OnDestroy, "team member", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     teamID := state.TeamID.ValueString()
     memberID := state.ID.ValueString()
 
@@ -2,6 +2,7 @@ package provider
 
 import (
     "context"
+    "encoding/json"
     "fmt"
 
     "github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -24,14 +25,116 @@ type workflowDataSource struct {
 }
 
 type workflowDataSourceModel struct {
-    ID              types.String   `tfsdk:"id"`
-    ProjectID       types.String   `tfsdk:"project_id"`
-    IntegrationID   types.String   `tfsdk:"integration_id"`
-    Description     types.String   `tfsdk:"description"`
-    DateCreated     types.String   `tfsdk:"date_created"`
-    DateUpdated     types.String   `tfsdk:"date_updated"`
-    Tags            []types.String `tfsdk:"tags"`
-    WorkflowVersion types.Int64    `tfsdk:"workflow_version"`
+    ID              types.String        `tfsdk:"id"`
+    ProjectID       types.String        `tfsdk:"project_id"`
+    IntegrationID   types.String        `tfsdk:"integration_id"`
+    Description     types.String        `tfsdk:"description"`
+    DateCreated     types.String        `tfsdk:"date_created"`
+    DateUpdated     types.String        `tfsdk:"date_updated"`
+    Tags            []types.String      `tfsdk:"tags"`
+    WorkflowVersion types.Int64         `tfsdk:"workflow_version"`
+    IsOnboarding    types.Bool          `tfsdk:"is_onboarding"`
+    Steps           []workflowStepModel `tfsdk:"steps"`
+    Definition      types.String        `tfsdk:"definition"`
+}
+
+type workflowStepModel struct {
+    ID          types.String `tfsdk:"id"`
+    Type        types.String `tfsdk:"type"`
+    Description types.String `tfsdk:"description"`
+    Status      types.String `tfsdk:"status"`
+    Next        types.String `tfsdk:"next"`
+    Parameters  types.String `tfsdk:"parameters"`
+}
+
+func workflowStepAttributes() map[string]schema.Attribute {
+    return map[string]schema.Attribute{
+        "id": schema.StringAttribute{
+            Description: "The ID of the step.",
+            Computed:    true,
+        },
+        "type": schema.StringAttribute{
+            Description: "The type of the step.",
+            Computed:    true,
+        },
+        "description": schema.StringAttribute{
+            Description: "The description of the step.",
+            Computed:    true,
+        },
+        "status": schema.StringAttribute{
+            Description: "The status of the step.",
+            Computed:    true,
+        },
+        "next": schema.StringAttribute{
+            Description: "The ID of the next step, empty for the last step.",
+            Computed:    true,
+        },
+        "parameters": schema.StringAttribute{
+            Description: "The parameters of the step, JSON encoded.",
+            Computed:    true,
+        },
+    }
+}
+
+// workflowDefinition is the part of a workflow describing its logic, without IDs of the workflow itself or dates,
+// so definitions of the same workflow in different projects can be compared.
+type workflowDefinition struct {
+    Description          string                   `json:"description"`
+    Tags                 []string                 `json:"tags"`
+    IsOnboardingWorkflow bool                     `json:"isOnboardingWorkflow"`
+    Steps                []workflowDefinitionStep `json:"steps"`
+}
+
+type workflowDefinitionStep struct {
+    ID          string                 `json:"id"`
+    Type        string                 `json:"type"`
+    Description string                 `json:"description"`
+    Next        string                 `json:"next,omitempty"`
+    Parameters  map[string]interface{} `json:"parameters"`
+}
+
+// workflowStepsAndDefinition converts the steps of a workflow and encodes its definition.
+func workflowStepsAndDefinition(workflow client.Workflow) ([]workflowStepModel, types.String, error) {
+    steps := []workflowStepModel{}
+    definition := workflowDefinition{
+        Description:          workflow.Description,
+        Tags:                 workflow.Tags,
+        IsOnboardingWorkflow: workflow.IsOnboardingWorkflow,
+        Steps:                []workflowDefinitionStep{},
+    }
+    if definition.Tags == nil {
+        definition.Tags = []string{}
+    }
+
+    for _, step := range workflow.Steps {
+        parameters, err := json.Marshal(step.Parameters)
+        if err != nil {
+            return nil, types.StringNull(), fmt.Errorf("could not encode parameters of step %s: %v", step.ID, err)
+        }
+
+        steps = append(steps, workflowStepModel{
+            ID:          types.StringValue(step.ID),
+            Type:        types.StringValue(step.Type),
+            Description: types.StringValue(step.Description),
+            Status:      types.StringValue(step.Status),
+            Next:        types.StringValue(step.Next),
+            Parameters:  types.StringValue(string(parameters)),
+        })
+        definition.Steps = append(definition.Steps, workflowDefinitionStep{
+            ID:          step.ID,
+            Type:        step.Type,
+            Description: step.Description,
+            Next:        step.Next,
+            Parameters:  step.Parameters,
+        })
+    }
+
+    encoded, err := json.Marshal(definition)
+    if err != nil {
+        return nil, types.StringNull(), fmt.Errorf("could not encode workflow definition: %v", err)
+    }
+
+    return steps, types.StringValue(string(encoded)), nil
 }
 
 func (d *workflowDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
@@ -87,6 +190,21 @@ func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaReques
                 Description: "The version of the workflow.",
                 Computed:    true,
             },
+            "is_onboarding": schema.BoolAttribute{
+                Description: "Indicates if the workflow is an onboarding workflow.",
+                Computed:    true,
+            },
+            "steps": schema.ListNestedAttribute{
+                Description: "The steps of the workflow.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: workflowStepAttributes(),
+                },
+            },
+            "definition": schema.StringAttribute{
+                Description: "The definition of the workflow (description, tags and steps), JSON encoded.",
+                Computed:    true,
+            },
         },
     }
 }
@@ -128,6 +246,24 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
         return
     }
 
+    workflow, err := d.client.LoadWorkflowSteps(ctx, projectID, *foundWorkflow)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Workflow",
+            err.Error(),
+        )
+        return
+    }
+
+    steps, definition, err := workflowStepsAndDefinition(workflow)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Workflow",
+            err.Error(),
+        )
+        return
+    }
+
     state := workflowDataSourceModel{
         ID:              types.StringValue(foundWorkflow.ID),
         ProjectID:       types.StringValue(foundWorkflow.ProjectID),
@@ -137,6 +273,9 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
         DateUpdated:     types.StringValue(foundWorkflow.DateUpdated),
         Tags:            client.ConvertStringSliceToTypesStringSlice(foundWorkflow.Tags),
         WorkflowVersion: types.Int64Value(int64(foundWorkflow.WorkflowVersion)),
+        IsOnboarding:    types.BoolValue(foundWorkflow.IsOnboardingWorkflow),
+        Steps:           steps,
+        Definition:      definition,
     }
 
     resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
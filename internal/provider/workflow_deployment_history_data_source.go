@@ -0,0 +1,147 @@
+package provider
+
+import (
+    "context"
+    "sort"
+
+    "github.com/hashicorp/terraform-plugin-framework/datasource"
+    "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/types"
+    "github.com/arielb135/terraform-provider-paragon/internal/client"
+)
+
+var (
+    _ datasource.DataSource              = &workflowDeploymentHistoryDataSource{}
+    _ datasource.DataSourceWithConfigure = &workflowDeploymentHistoryDataSource{}
+)
+
+func NewWorkflowDeploymentHistoryDataSource() datasource.DataSource {
+    return &workflowDeploymentHistoryDataSource{}
+}
+
+type workflowDeploymentHistoryDataSource struct {
+    client *client.Client
+}
+
+type workflowDeploymentHistoryDataSourceModel struct {
+    ProjectID  types.String             `tfsdk:"project_id"`
+    WorkflowID types.String             `tfsdk:"workflow_id"`
+    Migrations []workflowMigrationModel `tfsdk:"migrations"`
+}
+
+type workflowMigrationModel struct {
+    ID              types.String `tfsdk:"id"`
+    WorkflowVersion types.Int64  `tfsdk:"workflow_version"`
+    DeploymentID    types.String `tfsdk:"deployment_id"`
+    Status          types.String `tfsdk:"status"`
+    IsActive        types.Bool   `tfsdk:"is_active"`
+    DateCreated     types.String `tfsdk:"date_created"`
+    UserID          types.String `tfsdk:"user_id"`
+}
+
+func (d *workflowDeploymentHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+    if req.ProviderData == nil {
+        return
+    }
+
+    client, ok := req.ProviderData.(*client.Client)
+    if !ok {
+        return
+    }
+    d.client = client
+}
+
+func (d *workflowDeploymentHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+    resp.TypeName = req.ProviderTypeName + "_workflow_deployment_history"
+}
+
+func (d *workflowDeploymentHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+    resp.Schema = schema.Schema{
+        Description: "Fetches the deployment history (migrations) of a workflow, newest first.",
+        Attributes: map[string]schema.Attribute{
+            "project_id": schema.StringAttribute{
+                Description: "The ID of the project.",
+                Required:    true,
+            },
+            "workflow_id": schema.StringAttribute{
+                Description: "The ID of the workflow.",
+                Required:    true,
+            },
+            "migrations": schema.ListNestedAttribute{
+                Description: "The migrations of the workflow, newest first.",
+                Computed:    true,
+                NestedObject: schema.NestedAttributeObject{
+                    Attributes: map[string]schema.Attribute{
+                        "id": schema.StringAttribute{
+                            Description: "The ID of the migration.",
+                            Computed:    true,
+                        },
+                        "workflow_version": schema.Int64Attribute{
+                            Description: "The workflow version deployed by the migration.",
+                            Computed:    true,
+                        },
+                        "deployment_id": schema.StringAttribute{
+                            Description: "The ID of the deployment of the migration.",
+                            Computed:    true,
+                        },
+                        "status": schema.StringAttribute{
+                            Description: "The status of the deployment.",
+                            Computed:    true,
+                        },
+                        "is_active": schema.BoolAttribute{
+                            Description: "Indicates if the deployment is the active one.",
+                            Computed:    true,
+                        },
+                        "date_created": schema.StringAttribute{
+                            Description: "The creation date of the migration.",
+                            Computed:    true,
+                        },
+                        "user_id": schema.StringAttribute{
+                            Description: "The ID of the user who deployed the migration.",
+                            Computed:    true,
+                        },
+                    },
+                },
+            },
+        },
+    }
+}
+
+func (d *workflowDeploymentHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+    var config workflowDeploymentHistoryDataSourceModel
+    diags := req.Config.Get(ctx, &config)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    migrations, err := d.client.GetWorkflowMigrations(ctx, config.ProjectID.ValueString(), config.WorkflowID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Unable to Read Workflow Migrations",
+            err.Error(),
+        )
+        return
+    }
+
+    // ISO dates sort chronologically as strings
+    sort.SliceStable(migrations, func(i, j int) bool {
+        return migrations[i].DateCreated > migrations[j].DateCreated
+    })
+
+    config.Migrations = []workflowMigrationModel{}
+    for _, migration := range migrations {
+        config.Migrations = append(config.Migrations, workflowMigrationModel{
+            ID:              types.StringValue(migration.ID),
+            WorkflowVersion: types.Int64Value(int64(migration.WorkflowVersion)),
+            DeploymentID:    types.StringValue(migration.Deployment.ID),
+            Status:          types.StringValue(migration.Deployment.Status),
+            IsActive:        types.BoolValue(migration.Deployment.IsActive),
+            DateCreated:     types.StringValue(migration.DateCreated),
+            UserID:          types.StringValue(migration.UserID),
+        })
+    }
+
+    diags = resp.State.Set(ctx, &config)
+    resp.Diagnostics.Append(diags...)
+}
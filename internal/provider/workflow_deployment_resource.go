@@ -12,12 +12,17 @@ import (
     "github.com/arielb135/terraform-provider-paragon/internal/client"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
     "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+    "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 )
 
 var (
     _ resource.Resource              = &workflowDeploymentResource{}
     _ resource.ResourceWithConfigure = &workflowDeploymentResource{}
+    _ resource.ResourceWithModifyPlan = &workflowDeploymentResource{}
 )
 
 func NewWorkflowDeploymentResource() resource.Resource {
@@ -29,11 +34,25 @@ type workflowDeploymentResource struct {
 }
 
 type workflowDeploymentResourceModel struct {
-    ID         types.String `tfsdk:"id"`
-    ProjectID  types.String `tfsdk:"project_id"`
-    WorkflowID types.String `tfsdk:"workflow_id"`
-    Version    types.Int64  `tfsdk:"version"`
-    Deployed   types.Bool   `tfsdk:"deployed"`
+    ID                      types.String `tfsdk:"id"`
+    ProjectID               types.String `tfsdk:"project_id"`
+    WorkflowID              types.String `tfsdk:"workflow_id"`
+    Version                 types.Int64  `tfsdk:"version"`
+    Deployed                types.Bool   `tfsdk:"deployed"`
+    AutoRedeploy            types.Bool   `tfsdk:"auto_redeploy"`
+    DeployedWorkflowVersion types.Int64  `tfsdk:"deployed_workflow_version"`
+    LatestWorkflowVersion   types.Int64  `tfsdk:"latest_workflow_version"`
+    MigrationID             types.String `tfsdk:"migration_id"`
+    OnDestroy               types.String `tfsdk:"on_destroy"`
+}
+
+// isBehind reports whether the workflow was edited since it was deployed.
+func (m *workflowDeploymentResourceModel) isBehind() bool {
+    if m.DeployedWorkflowVersion.IsNull() || m.DeployedWorkflowVersion.IsUnknown() ||
+        m.LatestWorkflowVersion.IsNull() || m.LatestWorkflowVersion.IsUnknown() {
+        return false
+    }
+    return m.LatestWorkflowVersion.ValueInt64() > m.DeployedWorkflowVersion.ValueInt64()
 }
 
 func (r *workflowDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -87,10 +106,77 @@ func (r *workflowDeploymentResource) Schema(ctx context.Context, req resource.Sc
                 Description: "Indicates if the workflow is deployed.",
                 Computed:    true,
             },
+            "auto_redeploy": schema.BoolAttribute{
+                Description: "Redeploy the workflow when it was edited since its last deployment. (Default = true)",
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(true),
+            },
+            "migration_id": schema.StringAttribute{
+                Description: "Pin the deployment to a previous migration of the workflow, e.g. to roll back a bad release. Pinned deployments are not redeployed automatically.",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.LengthAtLeast(1),
+                },
+            },
+            "deployed_workflow_version": schema.Int64Attribute{
+                Description: "The workflow version that is deployed.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.Int64{
+                    int64planmodifier.UseStateForUnknown(),
+                },
+            },
+            "latest_workflow_version": schema.Int64Attribute{
+                Description: "The latest version of the workflow.",
+                Computed:    true,
+                PlanModifiers: []planmodifier.Int64{
+                    int64planmodifier.UseStateForUnknown(),
+                },
+            },
+            "on_destroy": onDestroyAttribute("workflow deployment", "undeploys the workflow", ""),
         },
     }
 }
 
+// ModifyPlan plans a redeployment when the version changed, or when the workflow was edited since it was deployed.
+func (r *workflowDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+    // Nothing to compare on create or destroy
+    if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+        return
+    }
+
+    var plan, state workflowDeploymentResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if r.needsRedeploy(&plan, &state) {
+        plan.ID = types.StringUnknown()
+        plan.DeployedWorkflowVersion = types.Int64Unknown()
+        // The workflow is read again when deploying, it may be edited until then
+        plan.LatestWorkflowVersion = types.Int64Unknown()
+    } else {
+        // Only flags changed, the deployment is kept
+        plan.ID = state.ID
+    }
+
+    resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// needsRedeploy reports whether applying the plan deploys the workflow again.
+func (r *workflowDeploymentResource) needsRedeploy(plan, state *workflowDeploymentResourceModel) bool {
+    if !plan.Version.Equal(state.Version) || !plan.MigrationID.Equal(state.MigrationID) || !state.Deployed.ValueBool() {
+        return true
+    }
+    // A pinned migration is kept even when the workflow moved ahead
+    if !plan.MigrationID.IsNull() {
+        return false
+    }
+    return plan.AutoRedeploy.ValueBool() && state.isBehind()
+}
+
 func (r *workflowDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
     var plan workflowDeploymentResourceModel
     diags := req.Plan.Get(ctx, &plan)
@@ -102,42 +188,39 @@ func (r *workflowDeploymentResource) Create(ctx context.Context, req resource.Cr
     projectID := plan.ProjectID.ValueString()
     workflowID := plan.WorkflowID.ValueString()
 
-    deploymentID, err := r.client.CreateWorkflowDeployment(ctx, projectID, workflowID)
+    // The version is read before deploying, so edits made during the deployment are detected later
+    workflow, err := r.client.GetWorkflow(ctx, projectID, workflowID)
     if err != nil {
         resp.Diagnostics.AddError(
-            "Error creating workflow deployment",
-            "Could not create workflow deployment, unexpected error: "+err.Error(),
+            "Error reading workflow",
+            "Could not read workflow, unexpected error: "+err.Error(),
         )
         return
     }
 
-    for {
-        deployment, err := r.client.GetWorkflowDeployment(ctx, projectID, deploymentID)
-        if err != nil {
-            resp.Diagnostics.AddError(
-                "Error retrieving workflow deployment status",
-                "Could not retrieve workflow deployment status, unexpected error: "+err.Error(),
-            )
-            return
-        }
-
-        if deployment.Status != "DEPLOYING" {
-            if deployment.Status == "DEPLOYED" {
-                plan.ID = types.StringValue(deploymentID)
-                plan.Deployed = types.BoolValue(true)
-                break
-            } else {
-                resp.Diagnostics.AddError(
-                    "Error creating workflow deployment",
-                    fmt.Sprintf("Workflow deployment failed with status: %s", deployment.Status),
-                )
-                return
-            }
-        }
+    deployedVersion, err := r.versionToDeploy(ctx, projectID, workflowID, plan.MigrationID.ValueString(), workflow)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading workflow migration",
+            "Could not read the pinned workflow migration, unexpected error: "+err.Error(),
+        )
+        return
+    }
 
-        time.Sleep(2 * time.Second)
+    deploymentID, err := deployWorkflowAndWait(ctx, r.client, projectID, workflowID, plan.MigrationID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error creating workflow deployment",
+            "Could not create workflow deployment, unexpected error: "+err.Error(),
+        )
+        return
     }
 
+    plan.ID = types.StringValue(deploymentID)
+    plan.Deployed = types.BoolValue(true)
+    plan.DeployedWorkflowVersion = types.Int64Value(deployedVersion)
+    plan.LatestWorkflowVersion = types.Int64Value(int64(workflow.WorkflowVersion))
+
     diags = resp.State.Set(ctx, plan)
     resp.Diagnostics.Append(diags...)
 }
@@ -150,6 +233,8 @@ func (r *workflowDeploymentResource) Read(ctx context.Context, req resource.Read
         return
     }
 
+    defaultDestroyAttributes(nil, &state.OnDestroy, onDestroyDelete)
+
     projectID := state.ProjectID.ValueString()
     workflowID := state.WorkflowID.ValueString()
 
@@ -180,6 +265,28 @@ func (r *workflowDeploymentResource) Read(ctx context.Context, req resource.Read
         return
     }
 
+    if migration.WorkflowVersion > 0 {
+        state.DeployedWorkflowVersion = types.Int64Value(int64(migration.WorkflowVersion))
+    }
+
+    workflow, err := r.client.GetWorkflow(ctx, projectID, workflowID)
+    if err != nil {
+        if !strings.Contains(err.Error(), "status code: 404") {
+            resp.Diagnostics.AddError(
+                "Error reading workflow",
+                "Could not read workflow, unexpected error: "+err.Error(),
+            )
+            return
+        }
+    } else {
+        state.LatestWorkflowVersion = types.Int64Value(int64(workflow.WorkflowVersion))
+    }
+
+    // Deployments made before the version was tracked are assumed to be up to date
+    if state.DeployedWorkflowVersion.IsNull() {
+        state.DeployedWorkflowVersion = state.LatestWorkflowVersion
+    }
+
     diags = resp.State.Set(ctx, &state)
     resp.Diagnostics.Append(diags...)
 }
@@ -192,45 +299,60 @@ func (r *workflowDeploymentResource) Update(ctx context.Context, req resource.Up
         return
     }
 
+    var state workflowDeploymentResourceModel
+    diags = req.State.Get(ctx, &state)
+    resp.Diagnostics.Append(diags...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    if !r.needsRedeploy(&plan, &state) {
+        plan.ID = state.ID
+        plan.Deployed = state.Deployed
+        plan.DeployedWorkflowVersion = state.DeployedWorkflowVersion
+        plan.LatestWorkflowVersion = state.LatestWorkflowVersion
+
+        diags = resp.State.Set(ctx, plan)
+        resp.Diagnostics.Append(diags...)
+        return
+    }
+
     projectID := plan.ProjectID.ValueString()
     workflowID := plan.WorkflowID.ValueString()
 
-    deploymentID, err := r.client.CreateWorkflowDeployment(ctx, projectID, workflowID)
+    // The version is read before deploying, so edits made during the deployment are detected later
+    workflow, err := r.client.GetWorkflow(ctx, projectID, workflowID)
     if err != nil {
         resp.Diagnostics.AddError(
-            "Error updating workflow deployment",
-            "Could not update workflow deployment, unexpected error: "+err.Error(),
+            "Error reading workflow",
+            "Could not read workflow, unexpected error: "+err.Error(),
         )
         return
     }
 
-    for {
-        deployment, err := r.client.GetWorkflowDeployment(ctx, projectID, deploymentID)
-        if err != nil {
-            resp.Diagnostics.AddError(
-                "Error retrieving workflow deployment status",
-                "Could not retrieve workflow deployment status, unexpected error: "+err.Error(),
-            )
-            return
-        }
-
-        if deployment.Status != "DEPLOYING" {
-            if deployment.Status == "DEPLOYED" {
-                plan.ID = types.StringValue(deploymentID)
-                plan.Deployed = types.BoolValue(true)
-                break
-            } else {
-                resp.Diagnostics.AddError(
-                    "Error updating workflow deployment",
-                    fmt.Sprintf("Workflow deployment failed with status: %s", deployment.Status),
-                )
-                return
-            }
-        }
+    deployedVersion, err := r.versionToDeploy(ctx, projectID, workflowID, plan.MigrationID.ValueString(), workflow)
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error reading workflow migration",
+            "Could not read the pinned workflow migration, unexpected error: "+err.Error(),
+        )
+        return
+    }
 
-        time.Sleep(2 * time.Second)
+    deploymentID, err := deployWorkflowAndWait(ctx, r.client, projectID, workflowID, plan.MigrationID.ValueString())
+    if err != nil {
+        resp.Diagnostics.AddError(
+            "Error updating workflow deployment",
+            "Could not update workflow deployment, unexpected error: "+err.Error(),
+        )
+        return
     }
 
+    plan.ID = types.StringValue(deploymentID)
+    plan.Deployed = types.BoolValue(true)
+    plan.DeployedWorkflowVersion = types.Int64Value(deployedVersion)
+    plan.LatestWorkflowVersion = types.Int64Value(int64(workflow.WorkflowVersion))
+
     diags = resp.State.Set(ctx, plan)
     resp.Diagnostics.Append(diags...)
 }
@@ -243,11 +365,14 @@ func (r *workflowDeploymentResource) Delete(ctx context.Context, req resource.De
         return
     }
 
+    if abandonOnDestroy(state.OnDestroy, "workflow deployment", state.ID.ValueString(), &resp.Diagnostics) {
+        return
+    }
+
     projectID := state.ProjectID.ValueString()
     workflowID := state.WorkflowID.ValueString()
 
-    // Call DeleteWorkflowDeployment to initiate the undeployment
-    err := r.client.DeleteWorkflowDeployment(ctx, projectID, workflowID)
+    err := undeployWorkflowAndWait(ctx, r.client, projectID, workflowID, state.ID.ValueString())
     if err != nil {
         resp.Diagnostics.AddError(
             "Error deleting workflow deployment",
@@ -255,32 +380,103 @@ func (r *workflowDeploymentResource) Delete(ctx context.Context, req resource.De
         )
         return
     }
+}
+
+// versionToDeploy returns the workflow version a deployment is going to deploy: the one of the pinned migration,
+// or the latest one.
+func (r *workflowDeploymentResource) versionToDeploy(ctx context.Context, projectID, workflowID, migrationID string, workflow *client.Workflow) (int64, error) {
+    if migrationID == "" {
+        return int64(workflow.WorkflowVersion), nil
+    }
+
+    migrations, err := r.client.GetWorkflowMigrations(ctx, projectID, workflowID)
+    if err != nil {
+        return 0, err
+    }
+
+    for _, migration := range migrations {
+        if migration.ID == migrationID {
+            return int64(migration.WorkflowVersion), nil
+        }
+    }
+
+    return 0, fmt.Errorf("migration %s not found for workflow %s", migrationID, workflowID)
+}
+
+// deployWorkflowAndWait deploys a workflow and waits until the deployment is done, returning the deployment ID.
+// The latest version of the workflow is deployed, unless a previous migration ID is given.
+func deployWorkflowAndWait(ctx context.Context, c *client.Client, projectID, workflowID, migrationID string) (string, error) {
+    var deploymentID string
+    var err error
+    if migrationID != "" {
+        deploymentID, err = c.CreateWorkflowDeploymentFromMigration(ctx, projectID, workflowID, migrationID)
+    } else {
+        deploymentID, err = c.CreateWorkflowDeployment(ctx, projectID, workflowID)
+    }
+    if err != nil {
+        return "", err
+    }
 
     for {
-        deployment, err := r.client.GetWorkflowDeployment(ctx, projectID, state.ID.ValueString())
+        deployment, err := c.GetWorkflowDeployment(ctx, projectID, deploymentID)
+        if err != nil {
+            return "", fmt.Errorf("could not retrieve workflow deployment status: %v", err)
+        }
+
+        if deployment.Status != client.WorkflowDeploymentStatusDeploying {
+            if deployment.Status == client.WorkflowDeploymentStatusDeployed {
+                return deploymentID, nil
+            }
+            return "", fmt.Errorf("workflow deployment failed with status: %s", deployment.Status)
+        }
+
+        if err := waitForDeployment(ctx); err != nil {
+            return "", err
+        }
+    }
+}
+
+// undeployWorkflowAndWait undeploys a workflow and waits until its deployment is undeployed or gone.
+func undeployWorkflowAndWait(ctx context.Context, c *client.Client, projectID, workflowID, deploymentID string) error {
+    // Call DeleteWorkflowDeployment to initiate the undeployment
+    err := c.DeleteWorkflowDeployment(ctx, projectID, workflowID)
+    if err != nil {
+        return err
+    }
+
+    if deploymentID == "" {
+        return nil
+    }
+
+    for {
+        deployment, err := c.GetWorkflowDeployment(ctx, projectID, deploymentID)
         if err != nil {
             if strings.Contains(err.Error(), "status code: 404") {
-                // The deployment is deleted (not found), break the loop
-                break
+                // The deployment is deleted (not found)
+                return nil
             }
-            resp.Diagnostics.AddError(
-                "Error retrieving workflow deployment status",
-                "Could not retrieve workflow deployment status, unexpected error: "+err.Error(),
-            )
-            return
+            return fmt.Errorf("could not retrieve workflow deployment status: %v", err)
         }
 
-        if deployment.Status == "UNDEPLOYED" {
-            // The deployment is successfully undeployed, break the loop
-            break
-        } else if deployment.Status != "UNDEPLOYING" {
-            resp.Diagnostics.AddError(
-                "Error deleting workflow deployment",
-                fmt.Sprintf("Workflow deployment failed to undeploy with status: %s", deployment.Status),
-            )
-            return
+        if deployment.Status == client.WorkflowDeploymentStatusUndeployed {
+            // The deployment is successfully undeployed
+            return nil
+        } else if deployment.Status != client.WorkflowDeploymentStatusUndeploying {
+            return fmt.Errorf("workflow deployment failed to undeploy with status: %s", deployment.Status)
+        }
+
+        if err := waitForDeployment(ctx); err != nil {
+            return err
         }
+    }
+}
 
-        time.Sleep(2 * time.Second)
+// waitForDeployment waits between two deployment status checks, unless the context is done.
+func waitForDeployment(ctx context.Context) error {
+    select {
+    case <-ctx.Done():
+        return ctx.Err()
+    case <-time.After(2 * time.Second):
+        return nil
     }
-}
\ No newline at end of file
+}
@@ -2,9 +2,14 @@ package provider
 
 import (
     "context"
+    "fmt"
+    "regexp"
 
     "github.com/hashicorp/terraform-plugin-framework/datasource"
     "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+    "github.com/hashicorp/terraform-plugin-framework/path"
+    "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+    "github.com/hashicorp/terraform-plugin-framework/schema/validator"
     "github.com/hashicorp/terraform-plugin-framework/types"
     "github.com/arielb135/terraform-provider-paragon/internal/client"
 
@@ -24,9 +29,13 @@ type workflowsDataSource struct {
 }
 
 type workflowsDataSourceModel struct {
-    ProjectID     types.String                  `tfsdk:"project_id"`
-    IntegrationID types.String                 `tfsdk:"integration_id"`
-    Workflows     []workflowDataSourceModel     `tfsdk:"workflows"`
+    ProjectID        types.String              `tfsdk:"project_id"`
+    IntegrationID    types.String              `tfsdk:"integration_id"`
+    Tags             []types.String            `tfsdk:"tags"`
+    DescriptionRegex types.String              `tfsdk:"description_regex"`
+    IsOnboarding     types.Bool                `tfsdk:"is_onboarding"`
+    DeploymentStatus types.String              `tfsdk:"deployment_status"`
+    Workflows        []workflowDataSourceModel `tfsdk:"workflows"`
 }
 
 
@@ -48,15 +57,35 @@ func (d *workflowsDataSource) Metadata(_ context.Context, req datasource.Metadat
 
 func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
     resp.Schema = schema.Schema{
-        Description: "Fetches a list of workflows by project ID and integration ID.",
+        Description: "Fetches a list of workflows of a project, optionally filtered.",
         Attributes: map[string]schema.Attribute{
             "project_id": schema.StringAttribute{
                 Description: "The ID of the project.",
                 Required:    true,
             },
             "integration_id": schema.StringAttribute{
-                Description: "The ID of the integration.",
-                Required:    true,
+                Description: "Only return the workflows of this integration. When omitted the workflows of all the integrations are returned.",
+                Optional:    true,
+            },
+            "tags": schema.SetAttribute{
+                Description: "Only return the workflows having at least one of these tags.",
+                Optional:    true,
+                ElementType: types.StringType,
+            },
+            "description_regex": schema.StringAttribute{
+                Description: "Only return the workflows whose description matches this regular expression.",
+                Optional:    true,
+            },
+            "is_onboarding": schema.BoolAttribute{
+                Description: "Only return onboarding workflows (true) or non-onboarding workflows (false).",
+                Optional:    true,
+            },
+            "deployment_status": schema.StringAttribute{
+                Description: "Only return the workflows that are deployed (DEPLOYED) or not deployed (UNDEPLOYED).",
+                Optional:    true,
+                Validators: []validator.String{
+                    stringvalidator.OneOf(client.WorkflowDeploymentStatusDeployed, client.WorkflowDeploymentStatusUndeployed),
+                },
             },
             "workflows": schema.ListNestedAttribute{
                 Description: "The list of workflows.",
@@ -96,6 +125,21 @@ func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
                             Description: "The version of the workflow.",
                             Computed:    true,
                         },
+                        "is_onboarding": schema.BoolAttribute{
+                            Description: "Indicates if the workflow is an onboarding workflow.",
+                            Computed:    true,
+                        },
+                        "steps": schema.ListNestedAttribute{
+                            Description: "The steps of the workflow.",
+                            Computed:    true,
+                            NestedObject: schema.NestedAttributeObject{
+                                Attributes: workflowStepAttributes(),
+                            },
+                        },
+                        "definition": schema.StringAttribute{
+                            Description: "The definition of the workflow (description, tags and steps), JSON encoded.",
+                            Computed:    true,
+                        },
                     },
                 },
             },
@@ -114,6 +158,25 @@ func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
     projectID := config.ProjectID.ValueString()
     integrationID := config.IntegrationID.ValueString()
 
+    var descriptionRegex *regexp.Regexp
+    if !config.DescriptionRegex.IsNull() {
+        var err error
+        descriptionRegex, err = regexp.Compile(config.DescriptionRegex.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("description_regex"),
+                "Invalid Description Regex",
+                fmt.Sprintf("Could not compile the regular expression: %s", err.Error()),
+            )
+            return
+        }
+    }
+
+    var tags []string
+    for _, tag := range config.Tags {
+        tags = append(tags, tag.ValueString())
+    }
+
     workflows, err := d.client.GetWorkflows(ctx, projectID, integrationID)
     if err != nil {
         resp.Diagnostics.AddError(
@@ -123,26 +186,78 @@ func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
         return
     }
 
-    var workflowModels []workflowDataSourceModel
+    // The deployment status is only fetched when filtering on it
+    var deployedWorkflows map[string]bool
+    if !config.DeploymentStatus.IsNull() {
+        migrations, err := d.client.GetLatestWorkflowMigrations(ctx, projectID)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Workflow Deployments",
+                err.Error(),
+            )
+            return
+        }
+        deployedWorkflows = make(map[string]bool)
+        for _, migration := range migrations {
+            if migration.Deployment.IsActive {
+                deployedWorkflows[migration.WorkflowID] = true
+            }
+        }
+    }
+
+    workflowModels := []workflowDataSourceModel{}
     for _, workflow := range workflows {
+        if len(tags) > 0 && !hasAnyTag(workflow.Tags, tags) {
+            continue
+        }
+        if descriptionRegex != nil && !descriptionRegex.MatchString(workflow.Description) {
+            continue
+        }
+        if !config.IsOnboarding.IsNull() && workflow.IsOnboardingWorkflow != config.IsOnboarding.ValueBool() {
+            continue
+        }
+        if deployedWorkflows != nil {
+            deployed := deployedWorkflows[workflow.ID]
+            if deployed != (config.DeploymentStatus.ValueString() == client.WorkflowDeploymentStatusDeployed) {
+                continue
+            }
+        }
+
+        workflow, err := d.client.LoadWorkflowSteps(ctx, projectID, workflow)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Workflow",
+                err.Error(),
+            )
+            return
+        }
+
+        steps, definition, err := workflowStepsAndDefinition(workflow)
+        if err != nil {
+            resp.Diagnostics.AddError(
+                "Unable to Read Workflow",
+                err.Error(),
+            )
+            return
+        }
+
         workflowModel := workflowDataSourceModel{
             ID:              types.StringValue(workflow.ID),
             Description:     types.StringValue(workflow.Description),
             ProjectID:       types.StringValue(projectID),
-            IntegrationID:   types.StringValue(integrationID),
+            IntegrationID:   types.StringValue(workflow.IntegrationID),
             DateCreated:     types.StringValue(workflow.DateCreated),
             DateUpdated:     types.StringValue(workflow.DateUpdated),
             Tags:            client.ConvertStringSliceToTypesStringSlice(workflow.Tags),
             WorkflowVersion: types.Int64Value(int64(workflow.WorkflowVersion)),
+            IsOnboarding:    types.BoolValue(workflow.IsOnboardingWorkflow),
+            Steps:           steps,
+            Definition:      definition,
         }
         workflowModels = append(workflowModels, workflowModel)
     }
 
-    state := workflowsDataSourceModel{
-        ProjectID:     types.StringValue(projectID),
-        IntegrationID: types.StringValue(integrationID),
-        Workflows:     workflowModels,
-    }
+    config.Workflows = workflowModels
 
-    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+    resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }